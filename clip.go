@@ -0,0 +1,120 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A ClipIndicator configures how a table shows that truncated columns hide part of their content
+// (e.g., after being narrowed to fit a total width set with SetTotalWidth).
+type ClipIndicator int
+
+const (
+	// ClipNone does not indicate clipped columns.
+	ClipNone ClipIndicator = iota
+	// ClipMarkHeader appends the clip marker to the header of every clipped column (e.g., "NAME ▸").
+	ClipMarkHeader
+	// ClipFootnote writes a line below the table listing the headers of the clipped columns (e.g., "clipped: NAME, DESCRIPTION").
+	ClipFootnote
+)
+
+// clipMarker is appended to the headers of clipped columns by ClipMarkHeader.
+var clipMarker = "▸"
+
+// SetClipIndicator sets how the table indicates columns whose non-header cells are truncated at render time,
+// so that readers know data is hidden. Only columns that truncate (see SetColumnOverflow and TruncateWideCells) can be clipped.
+// Columns that wrap show all their content. (Default: ClipNone).
+func (tbl *Table) SetClipIndicator(indicator ClipIndicator) {
+	tbl.clipIndicator = indicator
+}
+
+// clippedColumns returns the position of every column in a view with a non-header cell wider than its width in `colWidths`
+// that is truncated rather than wrapped.
+func (tbl *Table) clippedColumns(colWidths []int) []int {
+	var ret []int
+	for k := range colWidths {
+		if tbl.overflow(k) != OverflowTruncate {
+			continue
+		}
+		for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+			if maxLineWidth(tbl.rows.at(i).cells[k]) > colWidths[k] {
+				ret = append(ret, k)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// maxLineWidth returns the width of the widest line in `s`.
+func maxLineWidth(s string) int {
+	var ret int
+	for _, line := range strings.Split(s, "\n") {
+		if w := runeWidth(line); w > ret {
+			ret = w
+		}
+	}
+	return ret
+}
+
+// clipHeaderRow returns the position of the header row that names the columns in a view, or -1 if there are no header rows.
+// Any header subtext row is skipped.
+func (tbl *Table) clipHeaderRow() int {
+	i := tbl.numHeaderRows - 1
+	if tbl.headerSubtext != nil {
+		i--
+	}
+	return i
+}
+
+// markClippedHeaders appends the clip marker to the header of every column in `clipped`. Expects the table to be a view.
+// If the header would be truncated to its width in `colWidths`, the header text is truncated instead, so that the marker remains visible.
+func (tbl *Table) markClippedHeaders(clipped []int, colWidths []int) {
+	i := tbl.clipHeaderRow()
+	if i < 0 || len(clipped) == 0 {
+		return
+	}
+	// clipped columns truncate, so headers truncate too unless they have their own overflow
+	truncates := !tbl.limitHeaders() || tbl.headerOverflow == OverflowTruncate
+	row := tbl.rows.at(i)
+	cells := make([]string, len(row.cells))
+	copy(cells, row.cells)
+	for _, k := range clipped {
+		// the marker follows the last line of a multi-line header
+		text := strings.TrimRight(cells[k], " ")
+		var above string
+		if n := strings.LastIndexByte(text, '\n'); n >= 0 {
+			above, text = text[:n+1], text[n+1:]
+		}
+		avail := colWidths[k] - runeWidth(" "+clipMarker)
+		if truncates && runeWidth(text) > avail {
+			if avail < 1 {
+				cells[k] = above + clipMarker
+				continue
+			}
+			text = truncateCell(text, avail, false)
+		}
+		cells[k] = above + text + " " + clipMarker
+	}
+	row.cells = cells
+	tbl.rows.set(i, row)
+}
+
+// clipFootnote returns a line listing the headers of the columns in `clipped` (or their positions, if they have no header),
+// or an empty string if no columns are clipped.
+func (tbl *Table) clipFootnote(clipped []int) string {
+	if len(clipped) == 0 {
+		return ""
+	}
+	i := tbl.clipHeaderRow()
+	names := make([]string, len(clipped))
+	for n, k := range clipped {
+		if i >= 0 {
+			names[n] = strings.TrimSpace(tbl.rows.at(i).cells[k])
+		}
+		if names[n] == "" {
+			names[n] = strconv.Itoa(k)
+		}
+	}
+	return "clipped: " + strings.Join(names, ", ") + "\n"
+}
@@ -0,0 +1,117 @@
+package tablewriter
+
+import "testing"
+
+func TestTable_SetClipIndicator(t *testing.T) {
+	tests := []struct {
+		name      string
+		indicator ClipIndicator
+		want      string
+	}{
+		{"none", ClipNone, "" +
+			"+----+-----------+\n" +
+			"| id |   desc    |\n" +
+			"|----|-----------|\n" +
+			"| 1  | short     |\n" +
+			"| 2  | a much... |\n" +
+			"+----+-----------+\n"},
+		{"header", ClipMarkHeader, "" +
+			"+----+-----------+\n" +
+			"| id |  desc ▸   |\n" +
+			"|----|-----------|\n" +
+			"| 1  | short     |\n" +
+			"| 2  | a much... |\n" +
+			"+----+-----------+\n"},
+		{"footnote", ClipFootnote, "" +
+			"+----+-----------+\n" +
+			"| id |   desc    |\n" +
+			"|----|-----------|\n" +
+			"| 1  | short     |\n" +
+			"| 2  | a much... |\n" +
+			"+----+-----------+\n" +
+			"clipped: desc\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"id", "desc"}, [][]string{
+				{"1", "short"},
+				{"2", "a much longer description"},
+			})
+			tbl.SetColumnAlignment(1, AlignLeft)
+			tbl.TruncateWideCells()
+			tbl.SetTotalWidth(18)
+			tbl.SetClipIndicator(tt.indicator)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+			if tbl.rows.at(0).cells[1] != "desc" {
+				t.Errorf("Table.SetClipIndicator() changed stored header to %v", tbl.rows.at(0).cells[1])
+			}
+		})
+	}
+}
+
+func TestTable_SetClipIndicator_wrapped(t *testing.T) {
+	tbl := newTestTable([]string{"id", "desc"}, [][]string{{"1", "a much longer description"}})
+	tbl.SetTotalWidth(18)
+	tbl.SetClipIndicator(ClipFootnote)
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if clipped := tbl.view().clippedColumns([]int{2, 9}); clipped != nil {
+		t.Errorf("Table.clippedColumns() = %v, want none for wrapped columns", clipped)
+	}
+	if want := "+----+-----------+\n"; got[len(got)-len(want):] != want {
+		t.Errorf("Table.render() -> %v, want no footnote", got)
+	}
+}
+
+func TestTable_SetClipIndicator_truncatedHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		maxWidth int
+		want     string
+	}{
+		{"fits", "desc", 9, "" +
+			"+----+-----------+\n" +
+			"| id |  desc ▸   |\n" +
+			"|----|-----------|\n" +
+			"| 1  | a much... |\n" +
+			"+----+-----------+\n"},
+		{"truncated", "description", 9, "" +
+			"+----+-----------+\n" +
+			"| id | desc... ▸ |\n" +
+			"|----|-----------|\n" +
+			"| 1  | a much... |\n" +
+			"+----+-----------+\n"},
+		{"no room for text", "description", 2, "" +
+			"+----+----+\n" +
+			"| id | ▸  |\n" +
+			"|----|----|\n" +
+			"| 1  | a… |\n" +
+			"+----+----+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"id", tt.header}, [][]string{{"1", "a much longer description"}})
+			tbl.SetAlignment(AlignLeft)
+			tbl.TruncateWideCells()
+			tbl.SetHeaderOverflow(OverflowTruncate)
+			tbl.SetMaxColumnWidth(tt.maxWidth)
+			tbl.SetClipIndicator(ClipMarkHeader)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package tablewriter
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A ColumnType is the type of the values in a column. It determines, in one place, how cells are parsed
+// for sorting (see SortByColumn) and aggregation (see GroupBy and AddFooterAggregate), how the column is aligned,
+// and how its cells and aggregates are formatted.
+type ColumnType int
+
+const (
+	// TypeString parses cells as numbers where possible and otherwise compares them as text. Aggregates are plain numbers.
+	TypeString ColumnType = iota
+	// TypeInt is right-aligned, never wrapped or truncated, and formats integers with thousands separators (e.g., "1,234").
+	TypeInt
+	// TypeFloat is right-aligned, never wrapped or truncated, and formats numbers with thousands separators,
+	// keeping their decimal places (e.g., "1,234.5").
+	TypeFloat
+	// TypeDuration is right-aligned, never wrapped or truncated, and formats durations (e.g., "222s" or "222", in seconds) as "3m42s".
+	TypeDuration
+	// TypeBytes is right-aligned, never wrapped or truncated, and formats sizes in bytes (e.g., "1288490189" or "1.2GB")
+	// with binary units (e.g., "1.2GiB").
+	TypeBytes
+	// TypeTime is left-aligned, never wrapped or truncated, and formats times (RFC 3339, "2006-01-02 15:04:05", "2006-01-02",
+	// or Unix seconds) as "2006-01-02 15:04:05" in UTC.
+	TypeTime
+)
+
+// SetColumnType sets the type of the values in column `col` (starting at 0), and configures its alignment, overflow,
+// and formatting to match. Formatting applies only to non-header cells, and cells that cannot be parsed are left unchanged.
+// TypeString restores the table-wide alignment, overflow, and formatting.
+func (tbl *Table) SetColumnType(col int, typ ColumnType) {
+	tbl.updateColumn(col, func(c *columnSettings) {
+		c.ctype = typ
+		c.overflow = OverflowNone
+		c.format = typ.formatCell
+		switch typ {
+		case TypeInt, TypeFloat, TypeDuration, TypeBytes:
+			c.setAlignment(AlignRight)
+		case TypeTime:
+			c.setAlignment(AlignLeft)
+		default:
+			c.hasAlignment = false
+			c.overflow = OverflowDefault
+			c.format = nil
+		}
+	})
+}
+
+// SortByColumn stably sorts the non-header rows by the values in column `col`, parsed according to its type (see SetColumnType).
+// In typed columns, values that cannot be parsed sort after those that can, in lexical order. Pinned rows remain at the top or bottom of the table body.
+func (tbl *Table) SortByColumn(col int, descending bool) {
+	typ := tbl.columnType(col)
+	tbl.SortRows(func(a, b []string) bool {
+		if col >= len(a) || col >= len(b) {
+			return false
+		}
+		if descending {
+			return typ.less(b[col], a[col])
+		}
+		return typ.less(a[col], b[col])
+	})
+}
+
+// columnType returns the type of column `k`.
+func (tbl *Table) columnType(k int) ColumnType {
+	if k < 0 {
+		return TypeString
+	}
+	return tbl.column(k).ctype
+}
+
+// less compares `a` and `b` as values of type `t` if both can be parsed. Otherwise, for typed columns, parsed values sort first,
+// and the remaining values are compared lexically.
+func (t ColumnType) less(a, b string) bool {
+	fa, okA := t.parse(a)
+	fb, okB := t.parse(b)
+	switch {
+	case okA && okB:
+		return fa < fb
+	case okA != okB && t != TypeString:
+		return okA
+	default:
+		return a < b
+	}
+}
+
+// parse returns the value of `s` as a number: the number itself, the number of seconds in a duration,
+// the number of bytes in a size, or the Unix time of a time.
+func (t ColumnType) parse(s string) (float64, bool) {
+	switch t {
+	case TypeDuration:
+		return parseDuration(s)
+	case TypeBytes:
+		return parseBytes(s)
+	case TypeTime:
+		return parseTime(s)
+	default:
+		return parseNumber(s)
+	}
+}
+
+// formatValue formats the parsed value `f` (e.g., an aggregate) as a cell of type `t`.
+func (t ColumnType) formatValue(f float64) string {
+	switch t {
+	case TypeInt:
+		return groupThousands(strconv.FormatFloat(math.Round(f), 'f', 0, 64))
+	case TypeFloat:
+		return formatNumber(formatAggregate(f))
+	case TypeDuration:
+		return humanizeDuration(f)
+	case TypeBytes:
+		return humanizeBytes(f)
+	case TypeTime:
+		return time.Unix(0, int64(math.Round(f*1e9))).UTC().Format("2006-01-02 15:04:05")
+	default:
+		return formatAggregate(f)
+	}
+}
+
+// formatCell formats the cell `s` as a cell of type `t`, or returns it unchanged if it cannot be parsed.
+func (t ColumnType) formatCell(s string) string {
+	switch t {
+	case TypeInt:
+		return formatCount(s)
+	case TypeFloat:
+		return formatNumber(s)
+	}
+	f, ok := t.parse(s)
+	if !ok {
+		return s
+	}
+	return t.formatValue(f)
+}
+
+// parseDuration parses `s` as a duration (e.g., "3m42s") or a number of seconds, and returns the number of seconds.
+func parseDuration(s string) (float64, bool) {
+	if f, ok := parseNumber(s); ok {
+		return f, true
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(s))
+	if err != nil {
+		return 0, false
+	}
+	return d.Seconds(), true
+}
+
+// humanizeDuration formats `seconds` as a duration, rounded to the second if it is at least a second long (e.g., "1h2m3s").
+func humanizeDuration(seconds float64) string {
+	d := time.Duration(math.Round(seconds * float64(time.Second)))
+	if d >= time.Second || d <= -time.Second {
+		d = d.Round(time.Second)
+	}
+	return d.String()
+}
+
+// byteUnits are the binary units used by humanizeBytes, each 1024 times larger than the one before it.
+var byteUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+
+// byteMultipliers are the sizes in bytes of the units recognized by parseBytes (case-insensitive).
+var byteMultipliers = map[string]float64{
+	"": 1, "b": 1,
+	"k": 1 << 10, "kb": 1e3, "kib": 1 << 10,
+	"m": 1 << 20, "mb": 1e6, "mib": 1 << 20,
+	"g": 1 << 30, "gb": 1e9, "gib": 1 << 30,
+	"t": 1 << 40, "tb": 1e12, "tib": 1 << 40,
+	"p": 1 << 50, "pb": 1e15, "pib": 1 << 50,
+	"e": 1 << 60, "eb": 1e18, "eib": 1 << 60,
+}
+
+// parseBytes parses `s` as a size, optionally followed by a decimal or binary unit (e.g., "1.2GiB" or "1.5 MB"),
+// and returns the number of bytes.
+func parseBytes(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool { return r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' })
+	if i == -1 {
+		i = len(s)
+	}
+	multiplier, ok := byteMultipliers[strings.ToLower(s[i:])]
+	if !ok {
+		return 0, false
+	}
+	f, ok := parseNumber(s[:i])
+	if !ok {
+		return 0, false
+	}
+	return f * multiplier, true
+}
+
+// humanizeBytes formats `bytes` with the largest binary unit in which it is at least 1, with up to 1 decimal place (e.g., "1.2GiB").
+func humanizeBytes(bytes float64) string {
+	unit := 0
+	for math.Abs(bytes) >= 1024 && unit < len(byteUnits)-1 {
+		bytes /= 1024
+		unit++
+	}
+	return strconv.FormatFloat(math.Round(bytes*10)/10, 'f', -1, 64) + byteUnits[unit]
+}
+
+// parseTime parses `s` as a time in one of dateLayouts (in UTC, unless it has a zone) or as Unix seconds,
+// and returns the Unix time in seconds.
+func parseTime(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return float64(t.UnixNano()) / 1e9, true
+		}
+	}
+	return parseNumber(s)
+}
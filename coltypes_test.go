@@ -0,0 +1,86 @@
+package tablewriter
+
+import "testing"
+
+func TestTable_SetColumnType(t *testing.T) {
+	tbl := newTestTable([]string{"name", "size", "took"}, [][]string{
+		{"foo", "1288490189", "222"},
+		{"bar", "512", "1h2m3s"},
+		{"baz", "1.5MiB", "n/a"},
+	})
+	tbl.SetColumnType(1, TypeBytes)
+	tbl.SetColumnType(2, TypeDuration)
+	tbl.SortByColumn(1, true)
+	tbl.AddFooterAggregate(1, AggSum)
+	tbl.AddFooterAggregate(2, AggMax)
+	want := "" +
+		"+------+--------+--------+\n" +
+		"| name |  size  |  took  |\n" +
+		"|------|--------|--------|\n" +
+		"| foo  | 1.2GiB |  3m42s |\n" +
+		"| baz  | 1.5MiB |    n/a |\n" +
+		"| bar  |   512B | 1h2m3s |\n" +
+		"+------+--------+--------+\n" +
+		"|      | 1.2GiB | 1h2m3s |\n" +
+		"+------+--------+--------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	if got := tbl.rows.at(1).cells[1]; got != "1288490189" {
+		t.Errorf("Table.SetColumnType() changed stored cell to %v", got)
+	}
+}
+
+func TestColumnType_formatCell(t *testing.T) {
+	tests := []struct {
+		typ  ColumnType
+		s    string
+		want string
+	}{
+		{TypeInt, "1234567", "1,234,567"},
+		{TypeFloat, "1234.5", "1,234.5"},
+		{TypeFloat, "12345e3", "12345e3"},
+		{TypeDuration, "222", "3m42s"},
+		{TypeDuration, "0.25", "250ms"},
+		{TypeDuration, "90m", "1h30m0s"},
+		{TypeBytes, "1023", "1023B"},
+		{TypeBytes, "1536", "1.5KiB"},
+		{TypeBytes, "2GB", "1.9GiB"},
+		{TypeTime, "2020-03-27T15:04:05Z", "2020-03-27 15:04:05"},
+		{TypeTime, "1585321445", "2020-03-27 15:04:05"},
+		{TypeBytes, "foo", "foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := tt.typ.formatCell(tt.s); got != tt.want {
+				t.Errorf("ColumnType.formatCell() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnType_less(t *testing.T) {
+	tests := []struct {
+		typ  ColumnType
+		a, b string
+		want bool
+	}{
+		{TypeBytes, "900B", "1KiB", true},
+		{TypeDuration, "59s", "1m", true},
+		{TypeDuration, "foo", "1m", false},
+		{TypeTime, "2020-03-27", "2020-03-27 00:00:01", true},
+		{TypeString, "9", "10", true},
+		{TypeString, "foo", "10", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"<"+tt.b, func(t *testing.T) {
+			if got := tt.typ.less(tt.a, tt.b); got != tt.want {
+				t.Errorf("ColumnType.less() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,415 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// An Overflow configures how a column handles cells that are wider than the maximum column width.
+type Overflow int
+
+const (
+	// OverflowDefault uses the table-wide setting (wrap, unless TruncateWideCells is set).
+	OverflowDefault Overflow = iota
+	// OverflowWrap wraps the remainder of overly wide cells onto one or more new lines.
+	OverflowWrap
+	// OverflowTruncate truncates overly wide cells.
+	OverflowTruncate
+	// OverflowNone widens the column to fit its widest cell.
+	OverflowNone
+)
+
+// columnSettings holds the configuration for a single column. The zero value uses the table-wide settings.
+type columnSettings struct {
+	// kind is the ColumnKind last set with SetColumnKind
+	kind ColumnKind
+	// ctype is the ColumnType last set with SetColumnType
+	ctype    ColumnType
+	overflow Overflow
+	// alignment applies only if hasAlignment is true
+	alignment    Alignment
+	hasAlignment bool
+	// format, if not nil, transforms each non-header cell at render time
+	format func(string) string
+	// percent, if not nil, displays each non-header cell as a percentage (before any format is applied)
+	percent *percentSettings
+	// padding, if not nil, overrides the table-wide padding
+	padding *cellPadding
+	// minWidth is the minimum width of the column, excluding padding
+	minWidth int
+	// priority is when the column is narrowed to fit a total width
+	priority ShrinkPriority
+}
+
+// cellPadding is the number of spaces on either side of the text in a cell.
+type cellPadding struct {
+	left, right int
+}
+
+// defaultPadding applies if neither the table nor the column sets a padding.
+var defaultPadding = cellPadding{left: 1, right: 1}
+
+func newCellPadding(left, right int) *cellPadding {
+	if left < 0 {
+		left = 0
+	}
+	if right < 0 {
+		right = 0
+	}
+	return &cellPadding{left: left, right: right}
+}
+
+// percentSettings configures a column that displays its cells as percentages.
+type percentSettings struct {
+	// denominator is the column containing the denominator for each row, or -1 for the column total
+	denominator int
+	precision   int
+}
+
+func (c *columnSettings) setAlignment(alignment Alignment) {
+	c.alignment = alignment
+	c.hasAlignment = true
+}
+
+// column returns the settings for column `k`.
+func (tbl *Table) column(k int) columnSettings {
+	return tbl.columns[k]
+}
+
+// updateColumn applies `update` to the settings for column `k`.
+func (tbl *Table) updateColumn(k int, update func(*columnSettings)) {
+	if tbl.columns == nil {
+		tbl.columns = make(map[int]columnSettings)
+	}
+	settings := tbl.columns[k]
+	update(&settings)
+	tbl.columns[k] = settings
+}
+
+// shiftColumns returns a copy of the column settings with every column position increased by `n`,
+// e.g., to account for columns prepended at render time.
+func (tbl *Table) shiftColumns(n int) map[int]columnSettings {
+	if tbl.columns == nil {
+		return nil
+	}
+	ret := make(map[int]columnSettings, len(tbl.columns))
+	for k, settings := range tbl.columns {
+		if settings.percent != nil && settings.percent.denominator != -1 {
+			percent := *settings.percent
+			percent.denominator += n
+			settings.percent = &percent
+		}
+		ret[k+n] = settings
+	}
+	return ret
+}
+
+// SetColumnOverflow sets how column `col` (starting at 0) handles overly wide cells, overriding the table-wide setting.
+func (tbl *Table) SetColumnOverflow(col int, overflow Overflow) {
+	tbl.updateColumn(col, func(c *columnSettings) { c.overflow = overflow })
+}
+
+// overflow returns the overflow handling that applies to column `k`.
+func (tbl *Table) overflow(k int) Overflow {
+	if o := tbl.column(k).overflow; o != OverflowDefault {
+		return o
+	}
+	if tbl.truncateCells {
+		return OverflowTruncate
+	}
+	return OverflowWrap
+}
+
+// SetColumnAlignment sets the alignment of cells in column `col` (starting at 0), overriding the table-wide alignment.
+// Header cells are still centered unless header auto-centering is disabled.
+func (tbl *Table) SetColumnAlignment(col int, alignment Alignment) {
+	tbl.updateColumn(col, func(c *columnSettings) { c.setAlignment(alignment) })
+}
+
+// columnAlignment returns the table-wide or column-specific alignment that applies to column `k`.
+func (tbl *Table) columnAlignment(k int) Alignment {
+	if c := tbl.column(k); c.hasAlignment {
+		return c.alignment
+	}
+	return tbl.alignment
+}
+
+// EnableSmartAlignment aligns each column based on its non-header cells at render time:
+// columns in which every non-empty cell is a number (e.g., "1,234.5" or "12%") or a date are right-aligned, and other columns are left-aligned.
+// Columns aligned with SetColumnAlignment are unaffected, and columns without any non-empty cells use the table alignment.
+func (tbl *Table) EnableSmartAlignment() {
+	tbl.smartAlignment = true
+}
+
+// inferAlignments sets the alignment of every column without an explicit alignment, as described in EnableSmartAlignment.
+// Expects the table to be a view, whose column settings may be replaced.
+func (tbl *Table) inferAlignments() {
+	if tbl.rows.len() == 0 {
+		return
+	}
+	numCols := len(tbl.rows.at(0).cells)
+	tbl.columns = tbl.shiftColumns(0)
+	for k := 0; k < numCols; k++ {
+		if tbl.column(k).hasAlignment {
+			continue
+		}
+		var nonEmpty bool
+		alignment := AlignRight
+		for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+			cell := strings.TrimSpace(tbl.rows.at(i).cells[k])
+			if cell == "" || cell == tbl.placeholder {
+				continue
+			}
+			nonEmpty = true
+			if !isNumeric(cell) && !isDate(cell) {
+				alignment = AlignLeft
+				break
+			}
+		}
+		if nonEmpty {
+			tbl.updateColumn(k, func(c *columnSettings) { c.setAlignment(alignment) })
+		}
+	}
+}
+
+// hasDecimalAlignment returns true if the table or any of its columns is aligned with AlignDecimal.
+func (tbl *Table) hasDecimalAlignment() bool {
+	if tbl.alignment == AlignDecimal {
+		return true
+	}
+	for _, c := range tbl.columns {
+		if c.hasAlignment && c.alignment == AlignDecimal {
+			return true
+		}
+	}
+	return false
+}
+
+// alignDecimals pads the numbers in every column aligned with AlignDecimal so that their decimal points line up,
+// and right-aligns those columns. Expects the table to be a view, whose rows and column settings may be replaced.
+func (tbl *Table) alignDecimals() {
+	if tbl.rows.len() == 0 {
+		return
+	}
+	numCols := len(tbl.rows.at(0).cells)
+	tbl.columns = tbl.shiftColumns(0)
+	sep := tbl.decimalSeparator()
+	for k := 0; k < numCols; k++ {
+		if tbl.columnAlignment(k) != AlignDecimal {
+			continue
+		}
+		// the widest integer part (before the decimal point) and fractional part (including the decimal point)
+		var intWidth, fracWidth int
+		for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+			cell := strings.TrimSpace(tbl.rows.at(i).cells[k])
+			if !tbl.isLocalizedNumber(cell) {
+				continue
+			}
+			integer, fraction := splitDecimal(cell, sep)
+			if w := runeWidth(integer); w > intWidth {
+				intWidth = w
+			}
+			if w := runeWidth(fraction); w > fracWidth {
+				fracWidth = w
+			}
+		}
+		for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+			row := tbl.rows.at(i)
+			cell := strings.TrimSpace(row.cells[k])
+			if !tbl.isLocalizedNumber(cell) {
+				continue
+			}
+			integer, fraction := splitDecimal(cell, sep)
+			aligned := strings.Repeat(" ", intWidth-runeWidth(integer)) + integer + fraction + strings.Repeat(" ", fracWidth-runeWidth(fraction))
+			cells := make([]string, len(row.cells))
+			copy(cells, row.cells)
+			cells[k] = aligned
+			row.cells = cells
+			tbl.rows.set(i, row)
+		}
+		tbl.updateColumn(k, func(c *columnSettings) { c.setAlignment(AlignRight) })
+	}
+	if tbl.alignment == AlignDecimal {
+		tbl.alignment = AlignRight
+	}
+}
+
+// decimalSeparator returns the decimal separator of the numbers in a view: that of the locale, if one is set, or ".".
+func (tbl *Table) decimalSeparator() string {
+	if tbl.locale != nil && tbl.locale.FormatNumber == nil && tbl.locale.DecimalSeparator != "" {
+		return tbl.locale.DecimalSeparator
+	}
+	return "."
+}
+
+// isLocalizedNumber returns true if `s` is a number, written with the separators of the locale if one is set.
+func (tbl *Table) isLocalizedNumber(s string) bool {
+	if sep := tbl.decimalSeparator(); sep != "." {
+		if tbl.locale.GroupSeparator != "" {
+			s = strings.ReplaceAll(s, tbl.locale.GroupSeparator, "")
+		}
+		s = strings.Replace(s, sep, ".", 1)
+	}
+	_, ok := parseNumber(s)
+	return ok
+}
+
+// splitDecimal splits the number `s` into its integer part and its fractional part, which begins with the decimal separator `sep`.
+func splitDecimal(s string, sep string) (integer, fraction string) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i:]
+	}
+	return s, ""
+}
+
+// isNumeric returns true if `s` is a number, optionally followed by a percent sign.
+func isNumeric(s string) bool {
+	_, ok := parseNumber(strings.TrimSuffix(s, "%"))
+	return ok
+}
+
+// dateLayouts are the formats recognized as dates by EnableSmartAlignment.
+var dateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+// isDate returns true if `s` is a date in one of dateLayouts.
+func isDate(s string) bool {
+	for _, layout := range dateLayouts {
+		if _, err := time.Parse(layout, s); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// SetColumnPadding sets the number of spaces on the left and right of the text in each cell in column `col`,
+// overriding the table-wide padding. Negative values are treated as 0.
+func (tbl *Table) SetColumnPadding(col int, left, right int) {
+	tbl.updateColumn(col, func(c *columnSettings) { c.padding = newCellPadding(left, right) })
+}
+
+// columnPadding returns the effective padding of column `k`.
+func (tbl *Table) columnPadding(k int) cellPadding {
+	if tbl.spacing > 0 {
+		return cellPadding{}
+	}
+	if p := tbl.column(k).padding; p != nil {
+		return *p
+	}
+	if tbl.padding != nil {
+		return *tbl.padding
+	}
+	return defaultPadding
+}
+
+// paddingsInto writes the effective padding of each of `numCols` columns into `ret`, reusing its memory.
+func (tbl *Table) paddingsInto(ret []cellPadding, numCols int) []cellPadding {
+	ret = ret[:0]
+	for k := 0; k < numCols; k++ {
+		ret = append(ret, tbl.columnPadding(k))
+	}
+	return ret
+}
+
+// SetMinColumnWidth sets the minimum width of column `col` (excluding padding) to `width`,
+// so that narrow or empty columns keep a stable layout. (Default: 0).
+func (tbl *Table) SetMinColumnWidth(col int, width int) {
+	tbl.updateColumn(col, func(c *columnSettings) { c.minWidth = width })
+}
+
+// hasFormatters returns true if any column formats its cells at render time.
+func (tbl *Table) hasFormatters() bool {
+	for _, c := range tbl.columns {
+		if c.format != nil || c.percent != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// formatRow returns a copy of `row` with each cell transformed by its column's formatter, if any.
+// `totals` contains the total of each column that displays percentages of its total.
+func (tbl *Table) formatRow(row record, totals map[int]float64) record {
+	cells := make([]string, len(row.cells))
+	for k := range cells {
+		cells[k] = row.cells[k]
+		c := tbl.column(k)
+		if c.percent != nil {
+			cells[k] = formatShare(row.cells, k, *c.percent, totals[k])
+		}
+		if c.format != nil {
+			cells[k] = c.format(cells[k])
+		}
+		if tbl.locale != nil {
+			cells[k] = tbl.locale.localize(cells[k], row.cells[k], c)
+		}
+	}
+	return record{cells: cells, styles: row.styles}
+}
+
+// SetColumnPercentOfTotal displays each non-header cell in column `col` as a percentage of the column total at render time,
+// with `precision` decimal places. Cells that are not numbers are left unchanged.
+func (tbl *Table) SetColumnPercentOfTotal(col int, precision int) {
+	tbl.updateColumn(col, func(c *columnSettings) { c.percent = &percentSettings{denominator: -1, precision: precision} })
+}
+
+// SetColumnPercentOf displays each non-header cell in column `col` as a percentage of the cell in the same row in column `denominator`
+// at render time, with `precision` decimal places. Cells that are not numbers, or have a denominator of 0, are left unchanged.
+func (tbl *Table) SetColumnPercentOf(col int, denominator int, precision int) {
+	tbl.updateColumn(col, func(c *columnSettings) { c.percent = &percentSettings{denominator: denominator, precision: precision} })
+}
+
+// percentTotals returns the total of the non-header cells in `rows` in each column that displays percentages of its total.
+// Cells that are not numbers are ignored.
+func (tbl *Table) percentTotals(rows rowStore) map[int]float64 {
+	var ret map[int]float64
+	for k, c := range tbl.columns {
+		if c.percent == nil || c.percent.denominator != -1 {
+			continue
+		}
+		if ret == nil {
+			ret = make(map[int]float64)
+		}
+		for i := tbl.numHeaderRows; i < rows.len(); i++ {
+			cells := rows.at(i).cells
+			if k >= len(cells) {
+				break
+			}
+			if f, ok := parseNumber(cells[k]); ok {
+				ret[k] += f
+			}
+		}
+	}
+	return ret
+}
+
+// formatShare returns cells[k] as a percentage of either `total` or the denominator cell in the same row.
+func formatShare(cells []string, k int, percent percentSettings, total float64) string {
+	numerator, ok := parseNumber(cells[k])
+	if !ok {
+		return cells[k]
+	}
+	denominator := total
+	if percent.denominator != -1 {
+		if percent.denominator < 0 || percent.denominator >= len(cells) {
+			return cells[k]
+		}
+		denominator, ok = parseNumber(cells[percent.denominator])
+		if !ok {
+			return cells[k]
+		}
+	}
+	if denominator == 0 {
+		return cells[k]
+	}
+	return strconv.FormatFloat(numerator/denominator*100, 'f', percent.precision, 64) + "%"
+}
+
+// parseNumber parses `s` as a number, tolerating surrounding whitespace and thousands separators (e.g., " 1,234.5 ").
+func parseNumber(s string) (float64, bool) {
+	f, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
@@ -0,0 +1,283 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTable_SetColumnOverflow(t *testing.T) {
+	tbl := &Table{}
+	tbl.SetColumnOverflow(1, OverflowNone)
+	want := map[int]columnSettings{1: {overflow: OverflowNone}}
+	if !reflect.DeepEqual(tbl.columns, want) {
+		t.Errorf("Table.SetColumnOverflow().columns -> %v, want %v", tbl.columns, want)
+	}
+}
+
+func TestTable_overflow(t *testing.T) {
+	tests := []struct {
+		name          string
+		truncateCells bool
+		columns       map[int]columnSettings
+		want          []Overflow
+	}{
+		{"table default", false, nil, []Overflow{OverflowWrap, OverflowWrap}},
+		{"table truncate", true, nil, []Overflow{OverflowTruncate, OverflowTruncate}},
+		{"column override", true, map[int]columnSettings{1: {overflow: OverflowWrap}}, []Overflow{OverflowTruncate, OverflowWrap}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{truncateCells: tt.truncateCells, columns: tt.columns}
+			for k := range tt.want {
+				if got := tbl.overflow(k); got != tt.want[k] {
+					t.Errorf("Table.overflow(%d) = %v, want %v", k, got, tt.want[k])
+				}
+			}
+		})
+	}
+}
+
+func TestTable_render_columnOverflow(t *testing.T) {
+	tbl := newTestTable(nil, [][]string{{"0123456789", "the quick brown fox", "the quick brown fox"}})
+	tbl.SetAlignment(AlignLeft)
+	tbl.SetColumnOverflow(0, OverflowTruncate)
+	tbl.SetColumnOverflow(1, OverflowWrap)
+	tbl.SetColumnOverflow(2, OverflowNone)
+	tbl.EnableAutoIndex()
+	ChangeDefaults(Defaults{MaxColWidth: 9})
+	defer resetDefaults()
+
+	want := "" +
+		"+---++-----------+-----------+---------------------+\n" +
+		"| 1 || 012345... | the quic- | the quick brown fox |\n" +
+		"|   ||           | k brown   |                     |\n" +
+		"|   ||           | fox       |                     |\n" +
+		"+---++-----------+-----------+---------------------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() = %v, want %v", got, want)
+	}
+}
+
+func TestTable_render_percent(t *testing.T) {
+	tbl := newTestTable([]string{"name", "hits", "of total", "of hits"}, [][]string{
+		{"foo", "30", "30", "15"},
+		{"bar", "10", "10", "0"},
+		{"baz", "0", "n/a", "n/a"},
+	})
+	tbl.SetColumnPercentOfTotal(2, 1)
+	tbl.SetColumnPercentOf(3, 1, 0)
+	tbl.EnableAutoIndex()
+	want := "" +
+		"+---++------+------+----------+---------+\n" +
+		"|   || name | hits | of total | of hits |\n" +
+		"|---||------|------|----------|---------|\n" +
+		"| 1 || foo  |  30  |  75.0%   |   50%   |\n" +
+		"| 2 || bar  |  10  |  25.0%   |   0%    |\n" +
+		"| 3 || baz  |  0   |   n/a    |   n/a   |\n" +
+		"+---++------+------+----------+---------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() = %v, want %v", got, want)
+	}
+}
+
+func Test_parseNumber(t *testing.T) {
+	tests := []struct {
+		s      string
+		want   float64
+		wantOk bool
+	}{
+		{"1", 1, true},
+		{" 1,234.5 ", 1234.5, true},
+		{"-2", -2, true},
+		{"foo", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, ok := parseNumber(tt.s)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("parseNumber() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestTable_SetPadding(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right int
+		column      *[3]int
+		want        string
+	}{
+		{"zero padding", 0, 0, nil, "" +
+			"+---+---+\n" +
+			"|foo|bar|\n" +
+			"|---|---|\n" +
+			"| a | b |\n" +
+			"+---+---+\n"},
+		{"wide gutters", 2, 3, nil, "" +
+			"+--------+--------+\n" +
+			"|  foo   |  bar   |\n" +
+			"|--------|--------|\n" +
+			"|   a    |   b    |\n" +
+			"+--------+--------+\n"},
+		{"negative padding", -1, 0, nil, "" +
+			"+---+---+\n" +
+			"|foo|bar|\n" +
+			"|---|---|\n" +
+			"| a | b |\n" +
+			"+---+---+\n"},
+		{"column override", 0, 0, &[3]int{1, 2, 0}, "" +
+			"+---+-----+\n" +
+			"|foo|  bar|\n" +
+			"|---|-----|\n" +
+			"| a |   b |\n" +
+			"+---+-----+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"a", "b"}})
+			tbl.SetPadding(tt.left, tt.right)
+			if tt.column != nil {
+				tbl.SetColumnPadding(tt.column[0], tt.column[1], tt.column[2])
+			}
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetMinColumnWidth(t *testing.T) {
+	tbl := newTestTable([]string{"a", "b"}, [][]string{{"1", "2"}})
+	tbl.SetMinColumnWidth(1, 5)
+	tbl.SetMinColumnWidth(0, 0)
+	want := "" +
+		"+---+-------+\n" +
+		"| a |   b   |\n" +
+		"|---|-------|\n" +
+		"| 1 |   2   |\n" +
+		"+---+-------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_EnableStrictWidths(t *testing.T) {
+	ChangeDefaults(Defaults{MaxColWidth: 5})
+	defer resetDefaults()
+	tests := []struct {
+		name    string
+		cell    string
+		wantErr bool
+	}{
+		{"fits", "short", false},
+		{"too wide", "much too long", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo"}, [][]string{{tt.cell}})
+			tbl.EnableStrictWidths()
+			if _, err := tbl.render(); (err != nil) != tt.wantErr {
+				t.Errorf("Table.render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTable_EnableSmartAlignment(t *testing.T) {
+	tbl := newTestTable([]string{"name", "n", "date", "mixed", "empty", "fixed"}, [][]string{
+		{"foo", "1,000", "2020-01-02", "1", "", "1"},
+		{"barbaz", "12%", "2020-01-02 03:04:05", "x", "", "10"},
+	})
+	tbl.SetColumnAlignment(5, AlignCenter)
+	tbl.EnableSmartAlignment()
+	want := "" +
+		"+--------+-------+---------------------+-------+-------+-------+\n" +
+		"|  name  |   n   |        date         | mixed | empty | fixed |\n" +
+		"|--------|-------|---------------------|-------|-------|-------|\n" +
+		"| foo    | 1,000 |          2020-01-02 | 1     |       |   1   |\n" +
+		"| barbaz |   12% | 2020-01-02 03:04:05 | x     |       |  10   |\n" +
+		"+--------+-------+---------------------+-------+-------+-------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	if tbl.column(1).hasAlignment {
+		t.Errorf("Table.EnableSmartAlignment() changed stored column settings")
+	}
+}
+
+func TestTable_render_alignDecimal_locale(t *testing.T) {
+	tbl := newTestTable([]string{"item", "price"}, [][]string{
+		{"foo", "1234.5"},
+		{"bar", "12.25"},
+		{"baz", "7"},
+	})
+	tbl.SetColumnKind(1, KindNumber)
+	tbl.SetColumnAlignment(1, AlignDecimal)
+	tbl.SetLocale(LocaleDE)
+	want := "" +
+		"+------+----------+\n" +
+		"| item |  price   |\n" +
+		"|------|----------|\n" +
+		"| foo  | 1.234,5  |\n" +
+		"| bar  |    12,25 |\n" +
+		"| baz  |     7    |\n" +
+		"+------+----------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_render_alignDecimal(t *testing.T) {
+	tbl := newTestTable([]string{"item", "price"}, [][]string{
+		{"foo", "1,234.5"},
+		{"bar", "2"},
+		{"baz", "0.125"},
+		{"qux", "n/a"},
+	})
+	tbl.SetColumnAlignment(1, AlignDecimal)
+	want := "" +
+		"+------+-----------+\n" +
+		"| item |   price   |\n" +
+		"|------|-----------|\n" +
+		"| foo  | 1,234.5   |\n" +
+		"| bar  |     2     |\n" +
+		"| baz  |     0.125 |\n" +
+		"| qux  |       n/a |\n" +
+		"+------+-----------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	if tbl.columnAlignment(1) != AlignDecimal {
+		t.Errorf("Table.render() changed stored column alignment")
+	}
+}
@@ -0,0 +1,239 @@
+// Package compat provides a subset of the olekukonko/tablewriter API on top of tablewriter.Table,
+// so that projects migrating from that package can switch by changing their import path.
+// Settings are collected until Render, and rows may have different lengths (short rows are padded with empty cells).
+//
+// Separator symbols are library-wide in tablewriter (see tablewriter.ChangeDefaults), so the per-table
+// SetCenterSeparator, SetColumnSeparator, and SetRowSeparator methods are not provided.
+package compat
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ptiger10/tablewriter"
+)
+
+// Alignments accepted by SetAlignment and SetColumnAlignment.
+const (
+	ALIGN_DEFAULT = iota
+	ALIGN_CENTER
+	ALIGN_RIGHT
+	ALIGN_LEFT
+)
+
+// A Table collects the header, rows, footer, and settings of a table until it is rendered.
+type Table struct {
+	w                 io.Writer
+	header            []string
+	rows              [][]string
+	footer            []string
+	caption           string
+	border            bool
+	rowLine           bool
+	autoMerge         bool
+	autoFormatHeaders bool
+	autoWrapText      bool
+	colWidth          int
+	alignment         int
+	columnAlignments  []int
+}
+
+// NewWriter returns a table writing to `w`, with borders and automatic header formatting enabled.
+func NewWriter(w io.Writer) *Table {
+	return &Table{w: w, border: true, autoFormatHeaders: true, autoWrapText: true}
+}
+
+// SetHeader sets the header row.
+func (t *Table) SetHeader(keys []string) {
+	t.header = keys
+}
+
+// SetFooter sets the footer row, which is rendered below a dividing row.
+func (t *Table) SetFooter(keys []string) {
+	t.footer = keys
+}
+
+// SetCaption sets the text rendered below the table, if `caption` is true.
+func (t *Table) SetCaption(caption bool, captionText ...string) {
+	t.caption = ""
+	if caption {
+		t.caption = strings.Join(captionText, " ")
+	}
+}
+
+// Append appends a row.
+func (t *Table) Append(row []string) {
+	t.rows = append(t.rows, row)
+}
+
+// AppendBulk appends several rows.
+func (t *Table) AppendBulk(rows [][]string) {
+	t.rows = append(t.rows, rows...)
+}
+
+// NumLines returns the number of rows appended.
+func (t *Table) NumLines() int {
+	return len(t.rows)
+}
+
+// ClearRows removes all appended rows.
+func (t *Table) ClearRows() {
+	t.rows = nil
+}
+
+// ClearFooter removes the footer row.
+func (t *Table) ClearFooter() {
+	t.footer = nil
+}
+
+// SetBorder shows or hides the frame around the table.
+func (t *Table) SetBorder(border bool) {
+	t.border = border
+}
+
+// SetRowLine renders a dividing row between every pair of rows.
+func (t *Table) SetRowLine(line bool) {
+	t.rowLine = line
+}
+
+// SetAutoMergeCells merges repeated values in a column together.
+func (t *Table) SetAutoMergeCells(auto bool) {
+	t.autoMerge = auto
+}
+
+// SetAutoFormatHeaders uppercases header and footer cells and replaces "_" and "." with spaces.
+func (t *Table) SetAutoFormatHeaders(auto bool) {
+	t.autoFormatHeaders = auto
+}
+
+// SetAutoWrapText wraps cells wider than the column width, or widens the column to fit them if `auto` is false.
+func (t *Table) SetAutoWrapText(auto bool) {
+	t.autoWrapText = auto
+}
+
+// SetColWidth sets the maximum width of the cells in every column.
+func (t *Table) SetColWidth(width int) {
+	t.colWidth = width
+}
+
+// SetAlignment sets the alignment of every column. ALIGN_DEFAULT right-aligns numeric columns and left-aligns the rest.
+func (t *Table) SetAlignment(align int) {
+	t.alignment = align
+}
+
+// SetColumnAlignment sets the alignment of each column in turn.
+func (t *Table) SetColumnAlignment(keys []int) {
+	t.columnAlignments = keys
+}
+
+// Render writes the table to its io.Writer.
+func (t *Table) Render() error {
+	tbl, err := t.table()
+	if err != nil {
+		return fmt.Errorf("compat.Render(): %v", err)
+	}
+	var b bytes.Buffer
+	if err := tbl.RenderTo(&b); err != nil {
+		return fmt.Errorf("compat.Render(): %v", err)
+	}
+	if t.caption != "" {
+		b.WriteString(t.caption + "\n")
+	}
+	if _, err := t.w.Write(b.Bytes()); err != nil {
+		return fmt.Errorf("compat.Render(): %v", err)
+	}
+	return nil
+}
+
+// table returns a tablewriter.Table with the collected rows and settings.
+func (t *Table) table() (*tablewriter.Table, error) {
+	numCols := len(t.header)
+	if len(t.footer) > numCols {
+		numCols = len(t.footer)
+	}
+	for _, row := range t.rows {
+		if len(row) > numCols {
+			numCols = len(row)
+		}
+	}
+	tbl := tablewriter.NewTable(t.w)
+	if len(t.header) > 0 {
+		if err := tbl.AppendHeaderRow(t.formatHeader(pad(t.header, numCols))); err != nil {
+			return nil, err
+		}
+	}
+	for i, row := range t.rows {
+		if t.rowLine && i > 0 {
+			tbl.AppendDivider()
+		}
+		if err := tbl.AppendRow(pad(row, numCols)); err != nil {
+			return nil, err
+		}
+	}
+	if len(t.footer) > 0 {
+		tbl.AppendDivider()
+		if err := tbl.AppendRow(t.formatHeader(pad(t.footer, numCols))); err != nil {
+			return nil, err
+		}
+	}
+	if !t.border {
+		tbl.DisableBorders()
+	}
+	if t.autoMerge {
+		tbl.MergeRepeats()
+	}
+	if t.colWidth > 0 {
+		tbl.SetMaxColumnWidth(t.colWidth)
+	}
+	if t.alignment == ALIGN_DEFAULT {
+		tbl.SetAlignment(tablewriter.AlignLeft)
+		tbl.EnableSmartAlignment()
+	} else {
+		tbl.SetAlignment(alignment(t.alignment))
+	}
+	for k := 0; k < numCols; k++ {
+		if !t.autoWrapText {
+			tbl.SetColumnOverflow(k, tablewriter.OverflowNone)
+		}
+		if k < len(t.columnAlignments) && t.columnAlignments[k] != ALIGN_DEFAULT {
+			tbl.SetColumnAlignment(k, alignment(t.columnAlignments[k]))
+		}
+	}
+	return tbl, nil
+}
+
+// formatHeader returns `cells`, or a formatted copy of `cells` if headers are formatted automatically.
+func (t *Table) formatHeader(cells []string) []string {
+	if !t.autoFormatHeaders {
+		return cells
+	}
+	ret := make([]string, len(cells))
+	for k, cell := range cells {
+		ret[k] = strings.ToUpper(strings.NewReplacer("_", " ", ".", " ").Replace(cell))
+	}
+	return ret
+}
+
+// pad returns `row`, or a copy of `row` extended with empty cells to `n` cells.
+func pad(row []string, n int) []string {
+	if len(row) >= n {
+		return row
+	}
+	ret := make([]string, n)
+	copy(ret, row)
+	return ret
+}
+
+// alignment returns the tablewriter alignment equivalent to `align`.
+func alignment(align int) tablewriter.Alignment {
+	switch align {
+	case ALIGN_RIGHT:
+		return tablewriter.AlignRight
+	case ALIGN_LEFT:
+		return tablewriter.AlignLeft
+	default:
+		return tablewriter.AlignCenter
+	}
+}
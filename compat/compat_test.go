@@ -0,0 +1,69 @@
+package compat
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTable_Render(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Table)
+		want  string
+	}{
+		{"default", func(t *Table) {}, "" +
+			"+-------+------------+\n" +
+			"| NAME  | LAST LOGIN |\n" +
+			"|-------|------------|\n" +
+			"| alice |         12 |\n" +
+			"| bob   |            |\n" +
+			"+-------+------------+\n" +
+			"| TOTAL |         12 |\n" +
+			"+-------+------------+\n" +
+			"users\n"},
+		{"no border and row lines", func(t *Table) {
+			t.SetBorder(false)
+			t.SetRowLine(true)
+			t.SetAutoFormatHeaders(false)
+			t.SetAlignment(ALIGN_RIGHT)
+			t.SetCaption(false)
+		}, "" +
+			" name  | last_login \n" +
+			"-------|------------\n" +
+			" alice |         12 \n" +
+			"-------+------------\n" +
+			"   bob |            \n" +
+			"-------+------------\n" +
+			" total |         12 \n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			table := NewWriter(&b)
+			table.SetHeader([]string{"name", "last_login"})
+			table.Append([]string{"alice", "12"})
+			table.Append([]string{"bob"})
+			table.SetFooter([]string{"total", "12"})
+			table.SetCaption(true, "users")
+			tt.setup(table)
+			if err := table.Render(); err != nil {
+				t.Fatalf("Table.Render() error = %v", err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("Table.Render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_NumLines(t *testing.T) {
+	table := NewWriter(nil)
+	table.AppendBulk([][]string{{"a"}, {"b"}})
+	if got := table.NumLines(); got != 2 {
+		t.Errorf("Table.NumLines() -> %v, want 2", got)
+	}
+	table.ClearRows()
+	if got := table.NumLines(); got != 0 {
+		t.Errorf("Table.ClearRows().NumLines() -> %v, want 0", got)
+	}
+}
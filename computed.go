@@ -0,0 +1,41 @@
+package tablewriter
+
+// computedColumn is a virtual column whose cells are computed from the other cells in each row at render time.
+type computedColumn struct {
+	header  string
+	compute func(row []string) string
+}
+
+// AppendComputedColumn appends a virtual column named `header` to the table.
+// Each non-header cell in the column is computed at render time by calling `compute` with the stored cells in the same row
+// (e.g., a rate computed from a count column and a duration column).
+// Computed columns render like normal columns and may be configured by index, but are not stored in the table's rows.
+// In tables with multiple header rows, `header` appears in the last header row.
+func (tbl *Table) AppendComputedColumn(header string, compute func(row []string) string) {
+	tbl.computed = append(tbl.computed, computedColumn{header: header, compute: compute})
+}
+
+// withComputedColumns returns a copy of `rows` with the cells of any computed columns appended.
+func (tbl *Table) withComputedColumns(rows rowStore) rowStore {
+	var ret rowStore
+	for i := 0; i < rows.len(); i++ {
+		row := rows.at(i)
+		computed := record{cells: make([]string, len(row.cells), len(row.cells)+len(tbl.computed)), tags: row.tags}
+		copy(computed.cells, row.cells)
+		for _, c := range tbl.computed {
+			var cell string
+			if i >= tbl.numHeaderRows {
+				cell = c.compute(row.cells)
+			} else if i == tbl.numHeaderRows-1 {
+				cell = c.header
+			}
+			computed.cells = append(computed.cells, cell)
+		}
+		if row.styles != nil {
+			computed.styles = make([]Style, len(computed.cells))
+			copy(computed.styles, row.styles)
+		}
+		ret.push(computed)
+	}
+	return ret
+}
@@ -0,0 +1,75 @@
+package tablewriter
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/ptiger10/tablewriter/layout"
+)
+
+func TestTable_AppendComputedColumn(t *testing.T) {
+	rate := func(row []string) string {
+		count, _ := strconv.ParseFloat(row[1], 64)
+		seconds, _ := strconv.ParseFloat(row[2], 64)
+		if seconds == 0 {
+			return "n/a"
+		}
+		return strconv.FormatFloat(count/seconds, 'f', 1, 64)
+	}
+	tbl := newTestTable([]string{"name", "count", "seconds"}, [][]string{
+		{"foo", "10", "4"},
+		{"bar", "3", "0"},
+	})
+	tbl.AppendComputedColumn("rate", rate)
+	tbl.SetColumnAlignment(3, AlignRight)
+	want := "" +
+		"+------+-------+---------+------+\n" +
+		"| name | count | seconds | rate |\n" +
+		"|------|-------|---------|------|\n" +
+		"| foo  |  10   |    4    |  2.5 |\n" +
+		"| bar  |   3   |    0    |  n/a |\n" +
+		"+------+-------+---------+------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	if cells := tbl.rows.at(1).cells; len(cells) != 3 {
+		t.Errorf("Table.AppendComputedColumn() stored cells -> %v, want 3 cells", cells)
+	}
+}
+
+func TestTable_AppendComputedColumn_subtext(t *testing.T) {
+	tbl := newTestTable([]string{"n"}, [][]string{{"2"}})
+	tbl.SetHeaderSubtext([]string{"count"})
+	tbl.AppendComputedColumn("double", func(row []string) string {
+		n, _ := strconv.Atoi(row[0])
+		return strconv.Itoa(n * 2)
+	})
+	want := "" +
+		"+-------+--------+\n" +
+		"|   n   | double |\n" +
+		"| count |        |\n" +
+		"|-------|--------|\n" +
+		"|   2   |   4    |\n" +
+		"+-------+--------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got := layout.StripANSI(got); got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_withComputedColumns(t *testing.T) {
+	tbl := &Table{rows: newRowStore([][]string{{"a"}, {"b"}, {"c"}}), numHeaderRows: 2}
+	tbl.AppendComputedColumn("double", func(row []string) string { return row[0] + row[0] })
+	want := [][]string{{"a", ""}, {"b", "double"}, {"c", "cc"}}
+	if got := tbl.withComputedColumns(tbl.rows); !reflect.DeepEqual(got.all(), want) {
+		t.Errorf("Table.withComputedColumns() -> %v, want %v", got.all(), want)
+	}
+}
@@ -0,0 +1,366 @@
+package tablewriter
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// A ColumnPolicy configures how the columns in two tables are reconciled when the tables are combined.
+type ColumnPolicy int
+
+const (
+	// ColumnsStrict returns an error unless both tables have the same number of columns.
+	ColumnsStrict ColumnPolicy = iota
+	// ColumnsPad pads the rows in whichever table has fewer columns with empty cells on the right.
+	ColumnsPad
+	// ColumnsIntersect keeps only the columns whose header names appear in both tables, in the order of the receiving table.
+	ColumnsIntersect
+	// ColumnsUnion keeps every column in either table, matched by header name.
+	// Columns that appear only in the appended table are added to the right, and missing cells are empty.
+	ColumnsUnion
+)
+
+// AppendTable appends the non-header rows of `other` to the table, reconciling any differences in their columns according to `policy`.
+// Header names are taken from the final header row of each table, and are required by ColumnsIntersect and ColumnsUnion.
+// Names are matched as configured on the receiving table (see MatchColumnNamesExactly).
+// Column settings, footer aggregates, grouping, header subtext, and fixed widths move with the columns of the receiving table,
+// and fixed widths are cleared if columns are added. The dividers, pins, tags, cell styles, and lazy cells of `other` are kept.
+// If the table is empty, it adopts the header rows of `other` as well.
+func (tbl *Table) AppendTable(other *Table, policy ColumnPolicy) error {
+	if other.rows.len() == 0 {
+		return nil
+	}
+	if tbl.rows.len() == 0 {
+		for i := 0; i < other.rows.len(); i++ {
+			tbl.rows.append(copyRecord(other.rows.at(i)))
+		}
+		tbl.numHeaderRows = other.numHeaderRows
+		tbl.dividers = append(tbl.dividers, other.dividers...)
+		tbl.adoptFlags(other)
+		return nil
+	}
+	// existing and incoming map each column in the combined table to a column in the original tables (or -1 for an empty column)
+	existing, incoming, err := reconcileColumns(tbl, other, policy)
+	if err != nil {
+		return fmt.Errorf("appending table: %v", err)
+	}
+	if existing != nil {
+		tbl.remapExistingColumns(existing)
+		// columns that only exist in the appended table are named in the final header row
+		if policy == ColumnsUnion {
+			names := tbl.rows.at(tbl.numHeaderRows - 1).cells
+			otherNames := other.rows.at(other.numHeaderRows - 1).cells
+			for k := range existing {
+				if existing[k] == -1 && incoming[k] != -1 {
+					names[k] = otherNames[incoming[k]]
+				}
+			}
+		}
+	}
+	offset := tbl.NumRows()
+	for _, d := range other.dividers {
+		tbl.dividers = append(tbl.dividers, offset+d)
+	}
+	for i := other.numHeaderRows; i < other.rows.len(); i++ {
+		tbl.rows.append(copyRecord(remapRecord(other.rows.at(i), incoming)))
+	}
+	tbl.adoptFlags(other)
+	return nil
+}
+
+// adoptFlags records that the table has styles, pins, or lazy cells if `other` has any, after rows from `other` are added.
+func (tbl *Table) adoptFlags(other *Table) {
+	tbl.hasStyles = tbl.hasStyles || other.hasStyles
+	tbl.hasPins = tbl.hasPins || other.hasPins
+	tbl.hasValues = tbl.hasValues || other.hasValues
+}
+
+// remapExistingColumns reshapes the rows of the table so that column k contains the original column mapping[k] (or is empty if mapping[k] is -1),
+// and moves column settings, footer aggregates, grouping, header subtext, and fixed widths with their columns.
+// Settings for columns beyond the last column stay where they are.
+func (tbl *Table) remapExistingColumns(mapping []int) {
+	numCols := tbl.NumColumns()
+	tbl.remapColumns(mapping)
+	if tbl.headerSubtext != nil {
+		tbl.headerSubtext = remapRecord(record{cells: tbl.headerSubtext}, mapping).cells
+	}
+	if len(tbl.fixedWidths) == numCols {
+		widths := make([]int, len(mapping))
+		for k, col := range mapping {
+			if col == -1 {
+				widths = nil
+				break
+			}
+			widths[k] = tbl.fixedWidths[col]
+		}
+		tbl.fixedWidths = widths
+	}
+	// moved[k] is the new position of the original column k
+	moved := make([]int, numCols)
+	for k := range moved {
+		moved[k] = -1
+	}
+	for k, col := range mapping {
+		if col != -1 {
+			moved[col] = k
+		}
+	}
+	tbl.moveColumnSettings(func(k int) int {
+		if k >= numCols {
+			return k
+		}
+		return moved[k]
+	})
+}
+
+// Concat returns a new table with the header rows of the first table followed by the non-header rows of every table, in order,
+// so that partial tables produced separately can be combined before rendering. The new table writes to the io.Writer of the first table.
+// Every table must have the same number of columns and the same header rows, whose names are matched as configured on the first table
+// (see MatchColumnNamesExactly). Dividers, pins, tags, cell styles, and lazy cells are kept, while other settings are not.
+// Tables without rows are skipped, and if every table is empty, the new table is empty and writes to os.Stdout.
+func Concat(tables ...*Table) (*Table, error) {
+	ret := NewTable(os.Stdout)
+	var first *Table
+	for n, t := range tables {
+		if t == nil || t.rows.len() == 0 {
+			continue
+		}
+		if first == nil {
+			first = t
+			ret.w = t.w
+			ret.numHeaderRows = t.numHeaderRows
+			for i := 0; i < t.numHeaderRows; i++ {
+				ret.rows.append(copyRecord(t.rows.at(i)))
+			}
+		} else if err := first.sameHeaders(t); err != nil {
+			return nil, fmt.Errorf("tablewriter.Concat(): table %d: %v", n, err)
+		}
+		offset := ret.NumRows()
+		for _, d := range t.dividers {
+			ret.dividers = append(ret.dividers, offset+d)
+		}
+		for i := t.numHeaderRows; i < t.rows.len(); i++ {
+			ret.rows.append(copyRecord(t.rows.at(i)))
+		}
+		ret.adoptFlags(t)
+	}
+	return ret, nil
+}
+
+// sameHeaders returns an error unless `other` has the same number of columns and the same header rows as the table.
+func (tbl *Table) sameHeaders(other *Table) error {
+	if numCols, numOtherCols := tbl.NumColumns(), other.NumColumns(); numCols != numOtherCols {
+		return fmt.Errorf("tables must have same number of columns (%d != %d)", numOtherCols, numCols)
+	}
+	if tbl.numHeaderRows != other.numHeaderRows {
+		return fmt.Errorf("tables must have same number of header rows (%d != %d)", other.numHeaderRows, tbl.numHeaderRows)
+	}
+	for i := 0; i < tbl.numHeaderRows; i++ {
+		names, otherNames := tbl.rows.at(i).cells, other.rows.at(i).cells
+		for k := range names {
+			if tbl.matchName(names[k:k+1], otherNames[k]) == -1 {
+				return fmt.Errorf("header row %d, column %d: %q does not match %q", i, k, otherNames[k], names[k])
+			}
+		}
+	}
+	return nil
+}
+
+// ConcatHorizontal returns a new table with the columns of every table side by side, in order, writing to the io.Writer of the first table.
+// Every table must have the same number of non-header rows. Tables with fewer header rows than the others are padded with blank header rows on top.
+// Cell styles and lazy cells are kept, and the dividers, label levels, and row pins and tags of the first table apply to the new table,
+// while other settings are not kept. Tables without rows are skipped, and if every table is empty, the new table is empty and writes to os.Stdout.
+func ConcatHorizontal(tables ...*Table) (*Table, error) {
+	var parts []*Table
+	var numHeaderRows int
+	for n, t := range tables {
+		if t == nil || t.rows.len() == 0 {
+			continue
+		}
+		if len(parts) > 0 && t.NumRows() != parts[0].NumRows() {
+			return nil, fmt.Errorf("tablewriter.ConcatHorizontal(): table %d: tables must have same number of rows (%d != %d)", n, t.NumRows(), parts[0].NumRows())
+		}
+		if t.numHeaderRows > numHeaderRows {
+			numHeaderRows = t.numHeaderRows
+		}
+		parts = append(parts, t)
+	}
+	if len(parts) == 0 {
+		return NewTable(os.Stdout), nil
+	}
+	ret := NewTable(parts[0].w)
+	ret.numHeaderRows = numHeaderRows
+	ret.numLabelLevels = parts[0].numLabelLevels
+	ret.dividers = append([]int(nil), parts[0].dividers...)
+	ret.hasPins = parts[0].hasPins
+	srcs := make([]record, len(parts))
+	for i := 0; i < numHeaderRows+parts[0].NumRows(); i++ {
+		var hasStyles, hasValues bool
+		for p, t := range parts {
+			srcs[p] = t.concatRecord(i, numHeaderRows)
+			hasStyles = hasStyles || srcs[p].styles != nil
+			hasValues = hasValues || srcs[p].values != nil
+		}
+		r := record{pin: srcs[0].pin, tags: copyTags(srcs[0].tags)}
+		for _, src := range srcs {
+			r.cells = append(r.cells, src.cells...)
+			if hasStyles {
+				styles := src.styles
+				if styles == nil {
+					styles = make([]Style, len(src.cells))
+				}
+				r.styles = append(r.styles, styles...)
+			}
+			if hasValues {
+				for k := range src.cells {
+					if src.values != nil {
+						r.values = append(r.values, src.values[k])
+					} else {
+						r.values = append(r.values, CellText(src.cells[k]))
+					}
+				}
+			}
+		}
+		ret.rows.append(r)
+		ret.hasStyles = ret.hasStyles || hasStyles
+		ret.hasValues = ret.hasValues || hasValues
+	}
+	return ret, nil
+}
+
+// concatRecord returns the row at position `i` of the table when its header rows are padded on top to `numHeaderRows` rows,
+// or a blank row if `i` is a padded header row.
+func (tbl *Table) concatRecord(i, numHeaderRows int) record {
+	pad := numHeaderRows - tbl.numHeaderRows
+	if i < pad {
+		return record{cells: make([]string, tbl.NumColumns())}
+	}
+	return tbl.rows.at(i - pad)
+}
+
+// reconcileColumns returns the column mappings for the existing and incoming rows when appending `other` to `tbl`.
+// A nil mapping leaves the columns unchanged.
+func reconcileColumns(tbl, other *Table, policy ColumnPolicy) (existing, incoming []int, err error) {
+	numCols := len(tbl.rows.at(0).cells)
+	numOtherCols := len(other.rows.at(0).cells)
+	switch policy {
+	case ColumnsStrict:
+		if numCols != numOtherCols {
+			return nil, nil, fmt.Errorf("tables must have same number of columns (%d != %d)", numCols, numOtherCols)
+		}
+		return nil, nil, nil
+	case ColumnsPad:
+		if numOtherCols < numCols {
+			return nil, identityMapping(numOtherCols, numCols), nil
+		}
+		if numOtherCols > numCols {
+			return identityMapping(numCols, numOtherCols), nil, nil
+		}
+		return nil, nil, nil
+	case ColumnsIntersect, ColumnsUnion:
+		if tbl.numHeaderRows == 0 || other.numHeaderRows == 0 {
+			return nil, nil, fmt.Errorf("matching columns by name requires both tables to have header rows")
+		}
+		names := tbl.rows.at(tbl.numHeaderRows - 1).cells
+		otherNames := other.rows.at(other.numHeaderRows - 1).cells
+		if policy == ColumnsIntersect {
+			for k := range names {
+				if j := tbl.matchName(otherNames, names[k]); j != -1 {
+					existing = append(existing, k)
+					incoming = append(incoming, j)
+				}
+			}
+			if len(existing) == 0 {
+				return nil, nil, fmt.Errorf("tables have no column names in common")
+			}
+			return existing, incoming, nil
+		}
+		for k := range names {
+			existing = append(existing, k)
+			incoming = append(incoming, tbl.matchName(otherNames, names[k]))
+		}
+		for j := range otherNames {
+			if tbl.matchName(names, otherNames[j]) == -1 {
+				existing = append(existing, -1)
+				incoming = append(incoming, j)
+			}
+		}
+		return existing, incoming, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported column policy (%d)", policy)
+	}
+}
+
+// identityMapping maps the first `n` of `total` columns to themselves, and the remainder to empty columns.
+func identityMapping(n, total int) []int {
+	ret := make([]int, total)
+	for k := range ret {
+		ret[k] = k
+		if k >= n {
+			ret[k] = -1
+		}
+	}
+	return ret
+}
+
+// matchName returns the position of the column in `names` that matches `name`, or -1 if there is no match.
+// An exact match always takes precedence. Unless the table requires exact matches,
+// names are otherwise compared case-insensitively with leading, trailing, and repeated whitespace ignored.
+func (tbl *Table) matchName(names []string, name string) int {
+	for k := range names {
+		if names[k] == name {
+			return k
+		}
+	}
+	if tbl.exactColumnNames {
+		return -1
+	}
+	normalized := normalizeName(name)
+	for k := range names {
+		if strings.EqualFold(normalizeName(names[k]), normalized) {
+			return k
+		}
+	}
+	return -1
+}
+
+// normalizeName trims leading and trailing whitespace from `name` and collapses all other whitespace into single spaces.
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// remapColumns reshapes every row in the table according to `mapping` (see remapRecord).
+func (tbl *Table) remapColumns(mapping []int) {
+	for i := 0; i < tbl.rows.len(); i++ {
+		tbl.rows.set(i, remapRecord(tbl.rows.at(i), mapping))
+	}
+}
+
+// remapRecord returns a copy of `r` in which column k contains the original column mapping[k], or is empty if mapping[k] is -1.
+// A nil mapping returns `r` unchanged.
+func remapRecord(r record, mapping []int) record {
+	if mapping == nil {
+		return r
+	}
+	ret := record{cells: make([]string, len(mapping)), pin: r.pin, tags: r.tags}
+	if r.styles != nil {
+		ret.styles = make([]Style, len(mapping))
+	}
+	if r.values != nil {
+		ret.values = make([]CellValuer, len(mapping))
+	}
+	for k, j := range mapping {
+		if j == -1 {
+			continue
+		}
+		ret.cells[k] = r.cells[j]
+		if r.styles != nil {
+			ret.styles[k] = r.styles[j]
+		}
+		if r.values != nil {
+			ret.values[k] = r.values[j]
+		}
+	}
+	return ret
+}
@@ -0,0 +1,328 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ptiger10/tablewriter/layout"
+)
+
+func newTestTable(headers []string, rows [][]string) *Table {
+	tbl := NewTable(nil)
+	if headers != nil {
+		tbl.AppendHeaderRow(headers)
+	}
+	tbl.AppendRows(rows)
+	return tbl
+}
+
+func TestTable_AppendTable(t *testing.T) {
+	type args struct {
+		other  *Table
+		policy ColumnPolicy
+	}
+	tests := []struct {
+		name     string
+		tbl      *Table
+		args     args
+		wantRows [][]string
+		wantErr  bool
+	}{
+		{"empty table adopts headers",
+			NewTable(nil),
+			args{newTestTable([]string{"a"}, [][]string{{"foo"}}), ColumnsStrict},
+			[][]string{{"a"}, {"foo"}},
+			false},
+		{"empty other",
+			newTestTable([]string{"a"}, [][]string{{"foo"}}),
+			args{NewTable(nil), ColumnsStrict},
+			[][]string{{"a"}, {"foo"}},
+			false},
+		{"strict",
+			newTestTable([]string{"a"}, [][]string{{"foo"}}),
+			args{newTestTable([]string{"b"}, [][]string{{"bar"}}), ColumnsStrict},
+			[][]string{{"a"}, {"foo"}, {"bar"}},
+			false},
+		{"fail - strict",
+			newTestTable([]string{"a"}, [][]string{{"foo"}}),
+			args{newTestTable(nil, [][]string{{"bar", "baz"}}), ColumnsStrict},
+			[][]string{{"a"}, {"foo"}},
+			true},
+		{"pad - other narrower",
+			newTestTable([]string{"a", "b"}, [][]string{{"foo", "bar"}}),
+			args{newTestTable(nil, [][]string{{"baz"}}), ColumnsPad},
+			[][]string{{"a", "b"}, {"foo", "bar"}, {"baz", ""}},
+			false},
+		{"pad - other wider",
+			newTestTable([]string{"a"}, [][]string{{"foo"}}),
+			args{newTestTable(nil, [][]string{{"bar", "baz"}}), ColumnsPad},
+			[][]string{{"a", ""}, {"foo", ""}, {"bar", "baz"}},
+			false},
+		{"intersect",
+			newTestTable([]string{"a", "b", "c"}, [][]string{{"foo", "bar", "baz"}}),
+			args{newTestTable([]string{"c", "d", "a"}, [][]string{{"qux", "corge", "fred"}}), ColumnsIntersect},
+			[][]string{{"a", "c"}, {"foo", "baz"}, {"fred", "qux"}},
+			false},
+		{"intersect - case-insensitive and whitespace-normalized",
+			newTestTable([]string{"Job ID", "status"}, [][]string{{"foo", "bar"}}),
+			args{newTestTable([]string{"STATUS", " job  id"}, [][]string{{"baz", "qux"}}), ColumnsIntersect},
+			[][]string{{"Job ID", "status"}, {"foo", "bar"}, {"qux", "baz"}},
+			false},
+		{"fail - intersect - nothing in common",
+			newTestTable([]string{"a"}, [][]string{{"foo"}}),
+			args{newTestTable([]string{"b"}, [][]string{{"bar"}}), ColumnsIntersect},
+			[][]string{{"a"}, {"foo"}},
+			true},
+		{"fail - intersect - no headers",
+			newTestTable(nil, [][]string{{"foo"}}),
+			args{newTestTable([]string{"b"}, [][]string{{"bar"}}), ColumnsIntersect},
+			[][]string{{"foo"}},
+			true},
+		{"union",
+			newTestTable([]string{"a", "b"}, [][]string{{"foo", "bar"}}),
+			args{newTestTable([]string{"c", "a"}, [][]string{{"qux", "fred"}}), ColumnsUnion},
+			[][]string{{"a", "b", "c"}, {"foo", "bar", ""}, {"fred", "", "qux"}},
+			false},
+		{"fail - unsupported policy",
+			newTestTable([]string{"a"}, [][]string{{"foo"}}),
+			args{newTestTable([]string{"a"}, [][]string{{"bar"}}), ColumnPolicy(99)},
+			[][]string{{"a"}, {"foo"}},
+			true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.tbl.AppendTable(tt.args.other, tt.args.policy); (err != nil) != tt.wantErr {
+				t.Errorf("Table.AppendTable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := tt.tbl.rows.all(); !reflect.DeepEqual(got, tt.wantRows) {
+				t.Errorf("Table.AppendTable().rows -> %v, want %v", got, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestTable_AppendTable_settings(t *testing.T) {
+	t.Run("union with subtext", func(t *testing.T) {
+		tbl := newTestTable([]string{"a", "b"}, [][]string{{"foo", "bar"}})
+		tbl.SetHeaderSubtext([]string{"x", "y"})
+		if err := tbl.AppendTable(newTestTable([]string{"c", "a"}, [][]string{{"qux", "fred"}}), ColumnsUnion); err != nil {
+			t.Fatalf("Table.AppendTable() error = %v", err)
+		}
+		want := "" +
+			"+------+-----+-----+\n" +
+			"|  a   |  b  |  c  |\n" +
+			"|  x   |  y  |     |\n" +
+			"|------|-----|-----|\n" +
+			"| foo  | bar |     |\n" +
+			"| fred |     | qux |\n" +
+			"+------+-----+-----+\n"
+		got, err := tbl.render()
+		if err != nil {
+			t.Fatalf("Table.render() error = %v", err)
+		}
+		if got := layout.StripANSI(got); got != want {
+			t.Errorf("Table.render() -> %v, want %v", got, want)
+		}
+	})
+	t.Run("intersect with footer and alignment", func(t *testing.T) {
+		tbl := newTestTable([]string{"a", "b", "n"}, [][]string{{"foo", "bar", "1"}})
+		tbl.AddFooterAggregate(2, AggSum)
+		tbl.SetColumnAlignment(2, AlignLeft)
+		if err := tbl.AppendTable(newTestTable([]string{"n", "a"}, [][]string{{"20", "baz"}}), ColumnsIntersect); err != nil {
+			t.Fatalf("Table.AppendTable() error = %v", err)
+		}
+		want := "" +
+			"+-----+----+\n" +
+			"|  a  | n  |\n" +
+			"|-----|----|\n" +
+			"| foo | 1  |\n" +
+			"| baz | 20 |\n" +
+			"+-----+----+\n" +
+			"|     | 21 |\n" +
+			"+-----+----+\n"
+		got, err := tbl.render()
+		if err != nil {
+			t.Fatalf("Table.render() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("Table.render() -> %v, want %v", got, want)
+		}
+	})
+	t.Run("lazy rows, pins, and dividers", func(t *testing.T) {
+		other := newTestTable([]string{"a"}, [][]string{{"first"}})
+		other.AppendDivider()
+		other.AppendLazyRow([]CellValuer{CellFunc(func() string { return "live" })})
+		other.PinRow(1, PinTop)
+		tbl := newTestTable([]string{"a"}, [][]string{{"foo"}})
+		if err := tbl.AppendTable(other, ColumnsStrict); err != nil {
+			t.Fatalf("Table.AppendTable() error = %v", err)
+		}
+		want := "" +
+			"+-------+\n" +
+			"|   a   |\n" +
+			"|-------|\n" +
+			"| live  |\n" +
+			"| foo   |\n" +
+			"+-------+\n" +
+			"| first |\n" +
+			"+-------+\n"
+		tbl.SetAlignment(AlignLeft)
+		got, err := tbl.render()
+		if err != nil {
+			t.Fatalf("Table.render() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("Table.render() -> %v, want %v", got, want)
+		}
+		if !reflect.DeepEqual(tbl.dividers, []int{2}) {
+			t.Errorf("Table.AppendTable() dividers = %v, want [2]", tbl.dividers)
+		}
+	})
+	t.Run("empty table copies rows and dividers", func(t *testing.T) {
+		other := newTestTable([]string{"a"}, [][]string{{"foo"}})
+		other.AppendDivider()
+		other.AppendRow([]string{"bar"})
+		tbl := NewTable(nil)
+		if err := tbl.AppendTable(other, ColumnsStrict); err != nil {
+			t.Fatalf("Table.AppendTable() error = %v", err)
+		}
+		tbl.SetCell(0, 0, "changed")
+		if other.Row(0)[0] != "foo" {
+			t.Errorf("Table.AppendTable() shares cells with the appended table")
+		}
+		if !reflect.DeepEqual(tbl.dividers, []int{1}) {
+			t.Errorf("Table.AppendTable() dividers = %v, want [1]", tbl.dividers)
+		}
+	})
+}
+
+func Test_remapRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       record
+		mapping []int
+		want    record
+	}{
+		{"nil mapping", record{cells: []string{"foo"}}, nil, record{cells: []string{"foo"}}},
+		{"reorder and pad", record{cells: []string{"foo", "bar"}}, []int{1, -1, 0},
+			record{cells: []string{"bar", "", "foo"}}},
+		{"styles", record{cells: []string{"foo", "bar"}, styles: []Style{{Bold: true}, {}}}, []int{-1, 0},
+			record{cells: []string{"", "foo"}, styles: []Style{{}, {Bold: true}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := remapRecord(tt.r, tt.mapping); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("remapRecord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_matchName(t *testing.T) {
+	type args struct {
+		names []string
+		name  string
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      int
+		wantExact int
+	}{
+		{"exact", args{[]string{"foo", "bar"}, "bar"}, 1, 1},
+		{"case-insensitive", args{[]string{"foo", "bar"}, "BAR"}, 1, -1},
+		{"whitespace-normalized", args{[]string{"foo bar"}, " foo\t bar "}, 0, -1},
+		{"exact match takes precedence", args{[]string{"Foo", "foo"}, "foo"}, 1, 1},
+		{"no match", args{[]string{"foo"}, "bar"}, -1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{}
+			if got := tbl.matchName(tt.args.names, tt.args.name); got != tt.want {
+				t.Errorf("Table.matchName() = %v, want %v", got, tt.want)
+			}
+			tbl.MatchColumnNamesExactly()
+			if got := tbl.matchName(tt.args.names, tt.args.name); got != tt.wantExact {
+				t.Errorf("Table.matchName() with exact names = %v, want %v", got, tt.wantExact)
+			}
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	a := newTestTable([]string{"name", "n"}, [][]string{{"foo", "1"}})
+	b := newTestTable([]string{" Name ", "N"}, [][]string{{"bar", "2"}, {"baz", "3"}})
+	b.AppendDivider()
+	b.AppendRow([]string{"qux", "4"})
+	got, err := Concat(a, NewTable(nil), b)
+	if err != nil {
+		t.Fatalf("Concat() error = %v", err)
+	}
+	want := [][]string{{"foo", "1"}, {"bar", "2"}, {"baz", "3"}, {"qux", "4"}}
+	if !reflect.DeepEqual(got.HeaderRows(), [][]string{{"name", "n"}}) || !reflect.DeepEqual(got.Rows(), want) {
+		t.Errorf("Concat() -> %v %v, want [[name n]] %v", got.HeaderRows(), got.Rows(), want)
+	}
+	if !reflect.DeepEqual(got.dividers, []int{3}) {
+		t.Errorf("Concat() dividers = %v, want [3]", got.dividers)
+	}
+	got.SetCell(0, 0, "changed")
+	if a.Row(0)[0] != "foo" {
+		t.Errorf("Concat() shares cells with the original tables")
+	}
+
+	tests := []struct {
+		name  string
+		other *Table
+	}{
+		{"different header", newTestTable([]string{"name", "count"}, [][]string{{"bar", "2"}})},
+		{"no header", newTestTable(nil, [][]string{{"bar", "2"}})},
+		{"different columns", newTestTable([]string{"name", "n", "x"}, [][]string{{"bar", "2", ""}})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Concat(a, tt.other); err == nil {
+				t.Errorf("Concat() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestConcatHorizontal(t *testing.T) {
+	a := newTestTable([]string{"name"}, [][]string{{"foo"}, {"bar"}})
+	a.SetLabelLevelCount(1)
+	b := newTestTable(nil, [][]string{{"1", "2"}, {"3", "4"}})
+	b.AppendHeaderRow([]string{"x", "y"})
+	b.InsertHeaderRow(0, []string{"group", ""})
+	got, err := ConcatHorizontal(a, b)
+	if err != nil {
+		t.Fatalf("ConcatHorizontal() error = %v", err)
+	}
+	wantHeaders := [][]string{{"", "group", ""}, {"name", "x", "y"}}
+	wantRows := [][]string{{"foo", "1", "2"}, {"bar", "3", "4"}}
+	if !reflect.DeepEqual(got.HeaderRows(), wantHeaders) || !reflect.DeepEqual(got.Rows(), wantRows) {
+		t.Errorf("ConcatHorizontal() -> %v %v, want %v %v", got.HeaderRows(), got.Rows(), wantHeaders, wantRows)
+	}
+	if got.numLabelLevels != 1 {
+		t.Errorf("ConcatHorizontal() label levels = %d, want 1", got.numLabelLevels)
+	}
+	if _, err := ConcatHorizontal(a, newTestTable(nil, [][]string{{"1"}})); err == nil {
+		t.Errorf("ConcatHorizontal() with different row counts: error = nil, want error")
+	}
+}
+
+func TestConcatHorizontal_lazy(t *testing.T) {
+	a := newTestTable(nil, [][]string{{"foo"}})
+	b := NewTable(nil)
+	b.AppendLazyRow([]CellValuer{CellFunc(func() string { return "live" })})
+	got, err := ConcatHorizontal(a, b)
+	if err != nil {
+		t.Fatalf("ConcatHorizontal() error = %v", err)
+	}
+	want := "" +
+		"+-----+------+\n" +
+		"| foo | live |\n" +
+		"+-----+------+\n"
+	if s, err := got.render(); err != nil || s != want {
+		t.Errorf("Table.render() -> %v (%v), want %v", s, err, want)
+	}
+}
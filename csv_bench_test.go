@@ -0,0 +1,101 @@
+package tablewriter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// e.g., go test -run xxx -bench CSVToTable -csvsizes 1000,1000000
+var csvSizes = flag.String("csvsizes", "1000,100000", "comma-separated row counts for BenchmarkCSVToTable")
+
+// generateCSV creates a CSV with a header row and `numRows` rows of mixed-width content.
+func generateCSV(numRows int) []byte {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"id", "name", "status", "description"})
+	statuses := []string{"ok", "FAILED", "pending"}
+	for i := 0; i < numRows; i++ {
+		w.Write([]string{
+			strconv.Itoa(i),
+			fmt.Sprintf("job-%x", i*7919),
+			statuses[i%len(statuses)],
+			strings.Repeat("lorem ipsum ", i%6),
+		})
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
+// csvToTable converts the CSV in `r` (with a header row) into a table written to `w`.
+func csvToTable(r io.Reader, w io.Writer) error {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+	tbl := NewTable(w)
+	// records are reused by the reader, so cells must be copied
+	tbl.EnableArenaStorage()
+	for i := 0; ; i++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			err = tbl.AppendHeaderRow(append([]string{}, record...))
+		} else {
+			err = tbl.AppendRow(record)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return tbl.Render()
+}
+
+func TestCSVToTable(t *testing.T) {
+	for _, numRows := range []int{1, 10, rowChunkSize + 1} {
+		t.Run(strconv.Itoa(numRows), func(t *testing.T) {
+			var out bytes.Buffer
+			if err := csvToTable(bytes.NewReader(generateCSV(numRows)), &out); err != nil {
+				t.Fatalf("csvToTable() error = %v", err)
+			}
+			lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+			// header row, every body row (some of which wrap), and 3 dividing rows
+			if want := 1 + numRows + 3; len(lines) < want {
+				t.Errorf("csvToTable() -> %d lines, want at least %d", len(lines), want)
+			}
+			for i := range lines {
+				if runeWidth(lines[i]) != runeWidth(lines[0]) {
+					t.Errorf("csvToTable() line %d -> width %d, want %d", i, runeWidth(lines[i]), runeWidth(lines[0]))
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCSVToTable(b *testing.B) {
+	for _, size := range strings.Split(*csvSizes, ",") {
+		numRows, err := strconv.Atoi(strings.TrimSpace(size))
+		if err != nil {
+			b.Fatalf("-csvsizes: %v", err)
+		}
+		input := generateCSV(numRows)
+		b.Run(strconv.Itoa(numRows), func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				if err := csvToTable(bytes.NewReader(input), ioutil.Discard); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
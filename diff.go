@@ -0,0 +1,208 @@
+package tablewriter
+
+// Markers in the first column of a table returned by Diff.
+const (
+	diffAdded     = "+"
+	diffRemoved   = "-"
+	diffChanged   = "~"
+	diffUnchanged = ""
+)
+
+// diffStyles are the styles applied to added, removed, and changed rows in a table returned by Diff.
+var diffStyles = map[string]Style{
+	diffAdded:   {Foreground: ColorGreen},
+	diffRemoved: {Foreground: ColorRed},
+	diffChanged: {Foreground: ColorYellow},
+}
+
+// Diff returns a table comparing the non-header rows of `a` (old) with the non-header rows of `b` (updated),
+// for reporting configuration drift or snapshot test failures.
+// Each row is prefixed with a marker in a new label column: "+" for an added row (green), "-" for a removed row (red),
+// "~" for a changed row (yellow), or blank for an unchanged row.
+// Rows are matched in order, preserving the longest common sequence of identical rows.
+// A removed row and an added row with the same first cell, between the same pair of unchanged rows,
+// are reported as a single changed row in which each changed cell reads "old -> new".
+//
+// The returned table has the settings and header rows of `b` (or the header rows of `a`, if `b` has none),
+// and writes to the same io.Writer as `b`. Computed columns, groups, footers, and auto indexes are not carried over.
+// If the tables have different numbers of columns, the narrower rows are padded with empty cells on the right.
+func Diff(a, b *Table) *Table {
+	ret := b.Clone(false)
+	ret.rows = rowStore{}
+	ret.columns = b.shiftColumns(1)
+	ret.numLabelLevels++
+	ret.computed = nil
+	ret.groupBy = nil
+	ret.footer = nil
+	ret.autoIndex = false
+	ret.hasPins = false
+	ret.hasStyles = true
+
+	numCols := 0
+	if a.rows.len() > 0 {
+		numCols = len(a.rows.at(0).cells)
+	}
+	if b.rows.len() > 0 && len(b.rows.at(0).cells) > numCols {
+		numCols = len(b.rows.at(0).cells)
+	}
+	headers := b
+	if b.numHeaderRows == 0 {
+		headers = a
+	}
+	for i := 0; i < headers.numHeaderRows; i++ {
+		ret.rows.push(withMarker(headers.rows.at(i), diffUnchanged, numCols))
+	}
+	ret.numHeaderRows = headers.numHeaderRows
+
+	old, updated := a.bodyRecords(), b.bodyRecords()
+	ops := diffRecords(old, updated)
+	for n := 0; n < len(ops); {
+		if ops[n].marker == diffUnchanged {
+			ret.rows.push(withMarker(updated[ops[n].j], diffUnchanged, numCols))
+			n++
+			continue
+		}
+		// within each run of differences, pair every removed row with the first added row of the same key
+		var removed, added []int
+		for ; n < len(ops) && ops[n].marker != diffUnchanged; n++ {
+			if ops[n].marker == diffRemoved {
+				removed = append(removed, ops[n].i)
+			} else {
+				added = append(added, ops[n].j)
+			}
+		}
+		paired := make(map[int]bool)
+		for _, i := range removed {
+			j := -1
+			for _, candidate := range added {
+				if !paired[candidate] && sameKey(old[i].cells, updated[candidate].cells) {
+					j = candidate
+					break
+				}
+			}
+			if j == -1 {
+				ret.rows.push(withMarker(old[i], diffRemoved, numCols))
+				continue
+			}
+			paired[j] = true
+			ret.rows.push(withMarker(changedRecord(old[i].cells, updated[j].cells), diffChanged, numCols))
+		}
+		for _, j := range added {
+			if !paired[j] {
+				ret.rows.push(withMarker(updated[j], diffAdded, numCols))
+			}
+		}
+	}
+	return ret
+}
+
+// A diffOp is a single step in the edit script from the old rows to the updated rows.
+// `i` is the position of a removed or unchanged row in the old rows, and `j` is the position of an added or unchanged row in the updated rows.
+type diffOp struct {
+	marker string
+	i, j   int
+}
+
+// diffRecords returns the edit script from `old` to `updated` that preserves their longest common subsequence of identical rows.
+// Within each run of differences, removals precede additions.
+func diffRecords(old, updated []record) []diffOp {
+	// lcs[i][j] is the length of the longest common subsequence of old[i:] and updated[j:]
+	lcs := make([][]int, len(old)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(updated)+1)
+	}
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(updated) - 1; j >= 0; j-- {
+			if equalCells(old[i].cells, updated[j].cells) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var ops []diffOp
+	var added []diffOp
+	i, j := 0, 0
+	for i < len(old) || j < len(updated) {
+		switch {
+		case i < len(old) && j < len(updated) && equalCells(old[i].cells, updated[j].cells):
+			ops = append(append(ops, added...), diffOp{marker: diffUnchanged, i: i, j: j})
+			added = added[:0]
+			i++
+			j++
+		case i < len(old) && (j == len(updated) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{marker: diffRemoved, i: i, j: -1})
+			i++
+		default:
+			added = append(added, diffOp{marker: diffAdded, i: -1, j: j})
+			j++
+		}
+	}
+	return append(ops, added...)
+}
+
+// equalCells returns true if `a` and `b` contain the same cells.
+func equalCells(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k := range a {
+		if a[k] != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameKey returns true if `a` and `b` have the same first cell, which identifies a row that has changed.
+func sameKey(a, b []string) bool {
+	return len(a) > 0 && len(b) > 0 && a[0] == b[0]
+}
+
+// changedRecord returns a row in which every cell that differs between `old` and `updated` reads "old -> new".
+func changedRecord(old, updated []string) record {
+	n := len(updated)
+	if len(old) > n {
+		n = len(old)
+	}
+	cells := make([]string, n)
+	for k := range cells {
+		var before, after string
+		if k < len(old) {
+			before = old[k]
+		}
+		if k < len(updated) {
+			after = updated[k]
+		}
+		cells[k] = after
+		if before != after {
+			cells[k] = before + " -> " + after
+		}
+	}
+	return record{cells: cells}
+}
+
+// withMarker returns a copy of `row` with `marker` prepended, padded with empty cells to `numCols` columns (excluding the marker),
+// and styled according to the marker. Any styles already set on individual cells take precedence.
+func withMarker(row record, marker string, numCols int) record {
+	if numCols < len(row.cells) {
+		numCols = len(row.cells)
+	}
+	ret := record{cells: make([]string, numCols+1)}
+	ret.cells[0] = marker
+	copy(ret.cells[1:], row.cells)
+	style := diffStyles[marker]
+	if row.styles == nil && style == (Style{}) {
+		return ret
+	}
+	ret.styles = make([]Style, numCols+1)
+	for k := range ret.styles {
+		ret.styles[k] = style
+		if k > 0 && k-1 < len(row.styles) {
+			ret.styles[k] = row.styles[k-1].over(style)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,78 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	a := newTestTable([]string{"name", "n"}, [][]string{
+		{"foo", "1"},
+		{"bar", "2"},
+		{"baz", "3"},
+		{"qux", "4"},
+	})
+	b := newTestTable([]string{"name", "n"}, [][]string{
+		{"foo", "1"},
+		{"bar", "20"},
+		{"qux", "4"},
+		{"quux", "5"},
+	})
+	got := Diff(a, b)
+	want := [][]string{
+		{"", "name", "n"},
+		{"", "foo", "1"},
+		{"~", "bar", "2 -> 20"},
+		{"-", "baz", "3"},
+		{"", "qux", "4"},
+		{"+", "quux", "5"},
+	}
+	if !reflect.DeepEqual(got.rows.all(), want) {
+		t.Errorf("Diff() -> %v, want %v", got.rows.all(), want)
+	}
+	if got.numHeaderRows != 1 || got.numLabelLevels != 1 {
+		t.Errorf("Diff() -> %d header rows and %d label levels, want 1 and 1", got.numHeaderRows, got.numLabelLevels)
+	}
+	for i, color := range map[int]Color{1: ColorDefault, 2: ColorYellow, 3: ColorRed, 5: ColorGreen} {
+		var style Style
+		if styles := got.rows.at(i).styles; styles != nil {
+			style = styles[1]
+		}
+		if style.Foreground != color {
+			t.Errorf("Diff() row %d -> color %v, want %v", i, style.Foreground, color)
+		}
+	}
+	if a.rows.len() != 5 || b.rows.len() != 5 {
+		t.Errorf("Diff() changed the compared tables")
+	}
+}
+
+func Test_diffRecords(t *testing.T) {
+	records := func(cells ...string) []record {
+		var ret []record
+		for _, c := range cells {
+			ret = append(ret, record{cells: []string{c}})
+		}
+		return ret
+	}
+	tests := []struct {
+		name         string
+		old, updated []record
+		want         []diffOp
+	}{
+		{"empty", nil, nil, nil},
+		{"all added", nil, records("a"), []diffOp{{diffAdded, -1, 0}}},
+		{"all removed", records("a"), nil, []diffOp{{diffRemoved, 0, -1}}},
+		{"replaced", records("a", "b", "c"), records("a", "x", "c"),
+			[]diffOp{{diffUnchanged, 0, 0}, {diffRemoved, 1, -1}, {diffAdded, -1, 1}, {diffUnchanged, 2, 2}}},
+		{"reordered", records("a", "b"), records("b", "a"),
+			[]diffOp{{diffRemoved, 0, -1}, {diffUnchanged, 1, 0}, {diffAdded, -1, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffRecords(tt.old, tt.updated); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffRecords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package tablewriter
+
+import "fmt"
+
+// bodyRow returns the position in the row store of the non-header row at position `i` (0 is the first non-header row),
+// or an error if there is no such row.
+func (tbl *Table) bodyRow(i int) (int, error) {
+	if i < 0 || i >= tbl.NumRows() {
+		return 0, fmt.Errorf("row %d out of range [0:%d]", i, tbl.NumRows())
+	}
+	return tbl.numHeaderRows + i, nil
+}
+
+// SetCell replaces the cell in column `col` of the non-header row at position `row` (0 is the first non-header row) with `value`.
+// Any style, pin, or tags on the row are kept.
+func (tbl *Table) SetCell(row, col int, value string) error {
+	n, err := tbl.bodyRow(row)
+	if err != nil {
+		return fmt.Errorf("tbl.SetCell(): %v", err)
+	}
+	if col < 0 || col >= tbl.NumColumns() {
+		return fmt.Errorf("tbl.SetCell(): column %d out of range [0:%d]", col, tbl.NumColumns())
+	}
+	// copy the row, which may share memory with the caller's slice or with other rows in an arena
+	r := copyRecord(tbl.rows.at(n))
+	r.cells[col] = value
+	if r.values != nil {
+		r.values = append([]CellValuer(nil), r.values...)
+		r.values[col] = CellText(value)
+	}
+	tbl.rows.set(n, r)
+	return nil
+}
+
+// SetRow replaces the cells of the non-header row at position `i` (0 is the first non-header row) with `row`,
+// which must have the same number of fields as every other row. Any cell styles are cleared, and any pin or tags on the row are kept.
+func (tbl *Table) SetRow(i int, row []string) error {
+	n, err := tbl.bodyRow(i)
+	if err != nil {
+		return fmt.Errorf("tbl.SetRow(): %v", err)
+	}
+	cells, err := tbl.reshape(row, i)
+	if err != nil {
+		return fmt.Errorf("tbl.SetRow(): %v", err)
+	}
+	r := tbl.rows.at(n)
+	r.cells = cells
+	if tbl.rows.arena != nil {
+		r.cells = tbl.rows.arena.copyRow(cells)
+	}
+	r.styles = nil
+	r.values = nil
+	tbl.rows.set(n, r)
+	return nil
+}
+
+// RemoveRow removes the non-header row at position `i` (0 is the first non-header row).
+// Dividers after the removed row move up with the rows that follow it.
+func (tbl *Table) RemoveRow(i int) error {
+	n, err := tbl.bodyRow(i)
+	if err != nil {
+		return fmt.Errorf("tbl.RemoveRow(): %v", err)
+	}
+	tbl.rows.remove(n)
+	for k := range tbl.dividers {
+		if tbl.dividers[k] > i {
+			tbl.dividers[k]--
+		}
+	}
+	return nil
+}
+
+// InsertRow inserts `row` as the non-header row at position `index` (0 is the first non-header row),
+// shifting the rows at and after `index` down by one. An index equal to NumRows appends the row.
+// A row inserted at the position of a divider is placed after the divider, at the top of the section that follows it.
+func (tbl *Table) InsertRow(index int, row []string) error {
+	if index < 0 || index > tbl.NumRows() {
+		return fmt.Errorf("tbl.InsertRow(): row %d out of range [0:%d]", index, tbl.NumRows()+1)
+	}
+	cells, err := tbl.reshape(row, index)
+	if err != nil {
+		return fmt.Errorf("tbl.InsertRow(): %v", err)
+	}
+	tbl.rows.insert(tbl.numHeaderRows+index, record{cells: cells})
+	for k := range tbl.dividers {
+		if tbl.dividers[k] > index {
+			tbl.dividers[k]++
+		}
+	}
+	return nil
+}
+
+// InsertHeaderRow inserts `row` as the header row at position `index`, shifting the header rows at and after `index` down by one.
+// An index equal to NumHeaderRows is equivalent to AppendHeaderRow.
+func (tbl *Table) InsertHeaderRow(index int, row []string) error {
+	if index < 0 || index > tbl.numHeaderRows {
+		return fmt.Errorf("tbl.InsertHeaderRow(): header row %d out of range [0:%d]", index, tbl.numHeaderRows+1)
+	}
+	cells, err := tbl.reshape(row, -1)
+	if err != nil {
+		return fmt.Errorf("tbl.InsertHeaderRow(): %v", err)
+	}
+	tbl.rows.insert(index, record{cells: cells})
+	tbl.numHeaderRows++
+	return nil
+}
+
+// AppendColumn adds a column to the right of every row, with `header` in the final header row (other header rows are blank)
+// and `values` in the non-header rows, so that a table can be built one column at a time.
+// `values` must have one value per non-header row. If the table has no rows, it is created with one column,
+// with a header row only if `header` is not empty.
+func (tbl *Table) AppendColumn(header string, values []string) error {
+	if tbl.rows.len() == 0 {
+		if header != "" {
+			tbl.AppendHeaderRow([]string{header})
+		}
+		for _, v := range values {
+			tbl.AppendRow([]string{v})
+		}
+		return nil
+	}
+	if len(values) != tbl.NumRows() {
+		return fmt.Errorf("tbl.AppendColumn(): must have one value per non-header row (%d != %d)", len(values), tbl.NumRows())
+	}
+	numCols := tbl.NumColumns()
+	mapping := identityMapping(numCols, numCols+1)
+	tbl.remapColumns(mapping)
+	if tbl.numHeaderRows > 0 {
+		tbl.rows.at(tbl.numHeaderRows - 1).cells[numCols] = header
+	}
+	for i, v := range values {
+		r := tbl.rows.at(tbl.numHeaderRows + i)
+		r.cells[numCols] = v
+		if r.values != nil {
+			r.values[numCols] = CellText(v)
+		}
+	}
+	if tbl.headerSubtext != nil {
+		tbl.headerSubtext = append(tbl.headerSubtext, "")
+	}
+	return nil
+}
+
+// RemoveColumn removes column `col` (starting at 0) from every row.
+// Settings for the columns to its right (e.g., alignment, footer aggregates, and grouping) move with their columns,
+// and settings for the removed column are discarded. Computed columns are not adjusted.
+// Removing the only column leaves the table without any rows.
+func (tbl *Table) RemoveColumn(col int) error {
+	numCols := tbl.NumColumns()
+	if col < 0 || col >= numCols {
+		return fmt.Errorf("tbl.RemoveColumn(): column %d out of range [0:%d]", col, numCols)
+	}
+	if numCols == 1 {
+		tbl.rows.truncate(0)
+		tbl.numHeaderRows = 0
+		tbl.dividers = tbl.dividers[:0]
+		tbl.headerSubtext = nil
+		tbl.columns = nil
+		tbl.groupBy = nil
+		tbl.footer = nil
+		return nil
+	}
+	mapping := make([]int, 0, numCols-1)
+	for k := 0; k < numCols; k++ {
+		if k != col {
+			mapping = append(mapping, k)
+		}
+	}
+	tbl.remapColumns(mapping)
+	if tbl.headerSubtext != nil {
+		tbl.headerSubtext = append(tbl.headerSubtext[:col:col], tbl.headerSubtext[col+1:]...)
+	}
+	tbl.removeColumnSettings(col)
+	return nil
+}
+
+// removeColumnSettings discards the settings for column `col` and moves the settings for every column to its right one column left.
+func (tbl *Table) removeColumnSettings(col int) {
+	tbl.moveColumnSettings(func(k int) int {
+		switch {
+		case k == col:
+			return -1
+		case k > col:
+			return k - 1
+		default:
+			return k
+		}
+	})
+}
+
+// moveColumnSettings moves the settings for every column k to column moved(k), or discards them if moved(k) is -1.
+func (tbl *Table) moveColumnSettings(moved func(k int) int) {
+	if tbl.columns != nil {
+		columns := make(map[int]columnSettings, len(tbl.columns))
+		for k, settings := range tbl.columns {
+			if moved(k) == -1 {
+				continue
+			}
+			if settings.percent != nil && settings.percent.denominator != -1 {
+				percent := *settings.percent
+				percent.denominator = moved(percent.denominator)
+				settings.percent = &percent
+				if percent.denominator == -1 {
+					settings.percent = nil
+				}
+			}
+			columns[moved(k)] = settings
+		}
+		tbl.columns = columns
+	}
+	if tbl.footer != nil {
+		footer := make(map[int]Aggregator, len(tbl.footer))
+		for k, agg := range tbl.footer {
+			if moved(k) != -1 {
+				footer[moved(k)] = agg
+			}
+		}
+		tbl.footer = footer
+	}
+	if tbl.groupBy != nil {
+		if moved(tbl.groupBy.col) == -1 {
+			tbl.groupBy = nil
+			return
+		}
+		aggregators := make(map[int]Aggregator, len(tbl.groupBy.aggregators))
+		for k, agg := range tbl.groupBy.aggregators {
+			if moved(k) != -1 {
+				aggregators[moved(k)] = agg
+			}
+		}
+		tbl.groupBy = &groupSettings{col: moved(tbl.groupBy.col), aggregators: aggregators}
+	}
+}
+
+// ReorderColumns rearranges the columns of every row so that column k contains the original column order[k],
+// e.g., to present data collected in source order in a different display order.
+// `order` must list every column exactly once. Column settings, footer aggregates, grouping, and header subtext move with their columns.
+// Computed columns are not adjusted.
+func (tbl *Table) ReorderColumns(order []int) error {
+	numCols := tbl.NumColumns()
+	if len(order) != numCols {
+		return fmt.Errorf("tbl.ReorderColumns(): must list every column exactly once (%d != %d)", len(order), numCols)
+	}
+	// moved[k] is the new position of the original column k
+	moved := make([]int, numCols)
+	for k := range moved {
+		moved[k] = -1
+	}
+	for k, col := range order {
+		if col < 0 || col >= numCols {
+			return fmt.Errorf("tbl.ReorderColumns(): column %d out of range [0:%d]", col, numCols)
+		}
+		if moved[col] != -1 {
+			return fmt.Errorf("tbl.ReorderColumns(): column %d listed more than once", col)
+		}
+		moved[col] = k
+	}
+	tbl.remapColumns(order)
+	if tbl.headerSubtext != nil {
+		tbl.headerSubtext = remapRecord(record{cells: tbl.headerSubtext}, order).cells
+	}
+	if len(tbl.fixedWidths) == numCols {
+		widths := make([]int, numCols)
+		for k, col := range order {
+			widths[k] = tbl.fixedWidths[col]
+		}
+		tbl.fixedWidths = widths
+	}
+	tbl.moveColumnSettings(func(k int) int {
+		// settings for columns beyond the last column stay where they are
+		if k >= numCols {
+			return k
+		}
+		return moved[k]
+	})
+	return nil
+}
+
+// ReorderColumnsByName is like ReorderColumns, but lists the columns by their names in the final header row (see ColumnIndex).
+func (tbl *Table) ReorderColumnsByName(names []string) error {
+	order := make([]int, len(names))
+	for i, name := range names {
+		k, err := tbl.ColumnIndex(name)
+		if err != nil {
+			return fmt.Errorf("tbl.ReorderColumnsByName(): %v", err)
+		}
+		order[i] = k
+	}
+	if err := tbl.ReorderColumns(order); err != nil {
+		return fmt.Errorf("tbl.ReorderColumnsByName(): %v", err)
+	}
+	return nil
+}
+
+// Transpose swaps the rows and columns of the table, so that a wide table with few rows (e.g., a key/value summary) renders vertically.
+// Header rows become label columns, and label columns become header rows:
+// a table with header row [a b] and rows [1 2] and [3 4] becomes a table with rows [a 1 3] and [b 2 4] and 1 label level.
+// Cell styles move with their cells, while column settings, dividers, pins, tags, groups, footers, and header subtext are discarded.
+func (tbl *Table) Transpose() {
+	if tbl.rows.len() == 0 {
+		return
+	}
+	numRows, numCols := tbl.rows.len(), tbl.NumColumns()
+	var hasStyles bool
+	transposed := make([]record, numCols)
+	for k := range transposed {
+		transposed[k].cells = make([]string, numRows)
+		if tbl.hasValues {
+			transposed[k].values = make([]CellValuer, numRows)
+		}
+	}
+	for i := 0; i < numRows; i++ {
+		r := tbl.rows.at(i)
+		for k, cell := range r.cells {
+			transposed[k].cells[i] = cell
+			if tbl.hasValues {
+				// every transposed row may contain lazy cells, so fixed cells become CellText values
+				transposed[k].values[i] = CellText(cell)
+				if r.values != nil {
+					transposed[k].values[i] = r.values[k]
+				}
+			}
+			if r.styles != nil {
+				if transposed[k].styles == nil {
+					transposed[k].styles = make([]Style, numRows)
+				}
+				transposed[k].styles[i] = r.styles[k]
+				hasStyles = true
+			}
+		}
+	}
+	tbl.rows.truncate(0)
+	for _, r := range transposed {
+		tbl.rows.push(r)
+	}
+	tbl.numHeaderRows, tbl.numLabelLevels = tbl.numLabelLevels, tbl.numHeaderRows
+	if tbl.numHeaderRows > numCols {
+		tbl.numHeaderRows = numCols
+	}
+	tbl.hasStyles = tbl.hasStyles || hasStyles
+	tbl.hasPins = false
+	tbl.dividers = tbl.dividers[:0]
+	tbl.headerSubtext = nil
+	tbl.columns = nil
+	tbl.groupBy = nil
+	tbl.footer = nil
+}
@@ -0,0 +1,280 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTable_SetCell(t *testing.T) {
+	tests := []struct {
+		name     string
+		row, col int
+		wantRows [][]string
+		wantErr  bool
+	}{
+		{"pass", 1, 1, [][]string{{"name", "n"}, {"foo", "1"}, {"bar", "x"}}, false},
+		{"fail - row out of range", 2, 0, [][]string{{"name", "n"}, {"foo", "1"}, {"bar", "2"}}, true},
+		{"fail - negative row", -1, 0, [][]string{{"name", "n"}, {"foo", "1"}, {"bar", "2"}}, true},
+		{"fail - column out of range", 0, 2, [][]string{{"name", "n"}, {"foo", "1"}, {"bar", "2"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := [][]string{{"foo", "1"}, {"bar", "2"}}
+			tbl := newTestTable([]string{"name", "n"}, source)
+			if err := tbl.SetCell(tt.row, tt.col, "x"); (err != nil) != tt.wantErr {
+				t.Errorf("Table.SetCell() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.SetCell().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+			if source[1][1] != "2" {
+				t.Errorf("Table.SetCell() modified the appended slice")
+			}
+		})
+	}
+}
+
+func TestTable_SetRow(t *testing.T) {
+	tbl := newTestTable([]string{"name", "n"}, nil)
+	tbl.AppendStyledRow([]Cell{{Text: "foo", Style: Style{Bold: true}}, {Text: "1"}})
+	tbl.PinRow(0, PinTop)
+	if err := tbl.SetRow(0, []string{"bar", "2"}); err != nil {
+		t.Fatalf("Table.SetRow() error = %v", err)
+	}
+	got := tbl.rows.at(1)
+	if want := (record{cells: []string{"bar", "2"}, pin: PinTop}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.SetRow() -> %v, want %v", got, want)
+	}
+	if err := tbl.SetRow(0, []string{"bar"}); err == nil {
+		t.Errorf("Table.SetRow() with wrong shape error = nil, want error")
+	}
+	if err := tbl.SetRow(1, []string{"bar", "2"}); err == nil {
+		t.Errorf("Table.SetRow() out of range error = nil, want error")
+	}
+}
+
+func TestTable_RemoveRow(t *testing.T) {
+	tests := []struct {
+		name         string
+		i            int
+		wantRows     [][]string
+		wantDividers []int
+		wantErr      bool
+	}{
+		{"before divider", 0, [][]string{{"name"}, {"bar"}, {"baz"}}, []int{1}, false},
+		{"after divider", 2, [][]string{{"name"}, {"foo"}, {"bar"}}, []int{2}, false},
+		{"fail - out of range", 3, [][]string{{"name"}, {"foo"}, {"bar"}, {"baz"}}, []int{2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"name"}, [][]string{{"foo"}, {"bar"}})
+			tbl.AppendDivider()
+			tbl.AppendRow([]string{"baz"})
+			if err := tbl.RemoveRow(tt.i); (err != nil) != tt.wantErr {
+				t.Errorf("Table.RemoveRow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.RemoveRow().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+			if !reflect.DeepEqual(tbl.dividers, tt.wantDividers) {
+				t.Errorf("Table.RemoveRow().dividers -> %v, want %v", tbl.dividers, tt.wantDividers)
+			}
+		})
+	}
+}
+
+func TestTable_InsertRow(t *testing.T) {
+	tests := []struct {
+		name         string
+		index        int
+		row          []string
+		wantRows     [][]string
+		wantDividers []int
+		wantErr      bool
+	}{
+		{"first", 0, []string{"x"}, [][]string{{"name"}, {"x"}, {"foo"}, {"bar"}, {"baz"}}, []int{3}, false},
+		{"at divider", 2, []string{"x"}, [][]string{{"name"}, {"foo"}, {"bar"}, {"x"}, {"baz"}}, []int{2}, false},
+		{"last", 3, []string{"x"}, [][]string{{"name"}, {"foo"}, {"bar"}, {"baz"}, {"x"}}, []int{2}, false},
+		{"fail - out of range", 4, []string{"x"}, [][]string{{"name"}, {"foo"}, {"bar"}, {"baz"}}, []int{2}, true},
+		{"fail - wrong shape", 0, []string{"x", "y"}, [][]string{{"name"}, {"foo"}, {"bar"}, {"baz"}}, []int{2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"name"}, [][]string{{"foo"}, {"bar"}})
+			tbl.AppendDivider()
+			tbl.AppendRow([]string{"baz"})
+			if err := tbl.InsertRow(tt.index, tt.row); (err != nil) != tt.wantErr {
+				t.Errorf("Table.InsertRow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.InsertRow().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+			if !reflect.DeepEqual(tbl.dividers, tt.wantDividers) {
+				t.Errorf("Table.InsertRow().dividers -> %v, want %v", tbl.dividers, tt.wantDividers)
+			}
+		})
+	}
+}
+
+func TestTable_InsertHeaderRow(t *testing.T) {
+	tbl := newTestTable([]string{"name"}, [][]string{{"foo"}})
+	if err := tbl.InsertHeaderRow(0, []string{"group"}); err != nil {
+		t.Fatalf("Table.InsertHeaderRow() error = %v", err)
+	}
+	if want := [][]string{{"group"}, {"name"}, {"foo"}}; !reflect.DeepEqual(tbl.rows.all(), want) {
+		t.Errorf("Table.InsertHeaderRow().rows -> %v, want %v", tbl.rows.all(), want)
+	}
+	if tbl.numHeaderRows != 2 {
+		t.Errorf("Table.InsertHeaderRow().numHeaderRows -> %v, want 2", tbl.numHeaderRows)
+	}
+	if err := tbl.InsertHeaderRow(3, []string{"x"}); err == nil {
+		t.Errorf("Table.InsertHeaderRow() out of range error = nil, want error")
+	}
+}
+
+func TestTable_AppendColumn(t *testing.T) {
+	tests := []struct {
+		name     string
+		headers  []string
+		rows     [][]string
+		header   string
+		values   []string
+		wantRows [][]string
+		wantErr  bool
+	}{
+		{"pass", []string{"name"}, [][]string{{"foo"}, {"bar"}}, "n", []string{"1", "2"},
+			[][]string{{"name", "n"}, {"foo", "1"}, {"bar", "2"}}, false},
+		{"empty table", nil, nil, "n", []string{"1", "2"},
+			[][]string{{"n"}, {"1"}, {"2"}}, false},
+		{"fail - wrong length", []string{"name"}, [][]string{{"foo"}, {"bar"}}, "n", []string{"1"},
+			[][]string{{"name"}, {"foo"}, {"bar"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := NewTable(nil)
+			if tt.headers != nil {
+				tbl = newTestTable(tt.headers, tt.rows)
+			}
+			if err := tbl.AppendColumn(tt.header, tt.values); (err != nil) != tt.wantErr {
+				t.Errorf("Table.AppendColumn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.AppendColumn().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestTable_RemoveColumn(t *testing.T) {
+	tbl := newTestTable([]string{"name", "n", "price"}, [][]string{{"foo", "1", "2.5"}, {"bar", "2", "0.5"}})
+	tbl.PinRow(1, PinTop)
+	tbl.SetColumnAlignment(2, AlignRight)
+	tbl.SetColumnPercentOf(1, 0, 0)
+	tbl.AddFooterAggregate(2, AggSum)
+	tbl.AddFooterAggregate(0, AggCount)
+	tbl.GroupBy(1, map[int]Aggregator{2: AggMax})
+	if err := tbl.RemoveColumn(0); err != nil {
+		t.Fatalf("Table.RemoveColumn() error = %v", err)
+	}
+	if want := [][]string{{"n", "price"}, {"1", "2.5"}, {"2", "0.5"}}; !reflect.DeepEqual(tbl.rows.all(), want) {
+		t.Errorf("Table.RemoveColumn().rows -> %v, want %v", tbl.rows.all(), want)
+	}
+	if tbl.rows.at(2).pin != PinTop {
+		t.Errorf("Table.RemoveColumn() did not keep row pins")
+	}
+	if got := tbl.columnAlignment(1); got != AlignRight {
+		t.Errorf("Table.RemoveColumn() alignment of column 1 -> %v, want %v", got, AlignRight)
+	}
+	if tbl.column(0).percent != nil {
+		t.Errorf("Table.RemoveColumn() kept a percent of a removed column")
+	}
+	if want := map[int]Aggregator{1: AggSum}; !reflect.DeepEqual(tbl.footer, want) {
+		t.Errorf("Table.RemoveColumn().footer -> %v, want %v", tbl.footer, want)
+	}
+	if want := (&groupSettings{col: 0, aggregators: map[int]Aggregator{1: AggMax}}); !reflect.DeepEqual(tbl.groupBy, want) {
+		t.Errorf("Table.RemoveColumn().groupBy -> %v, want %v", tbl.groupBy, want)
+	}
+	if err := tbl.RemoveColumn(2); err == nil {
+		t.Errorf("Table.RemoveColumn() out of range error = nil, want error")
+	}
+	tbl.RemoveColumn(0)
+	tbl.RemoveColumn(0)
+	if tbl.rows.len() != 0 || tbl.numHeaderRows != 0 {
+		t.Errorf("Table.RemoveColumn() of last column -> %v rows, want 0", tbl.rows.len())
+	}
+}
+
+func TestTable_ReorderColumns(t *testing.T) {
+	tbl := newTestTable([]string{"name", "n", "price"}, [][]string{{"foo", "1", "2.5"}, {"bar", "2", "0.5"}})
+	tbl.SetColumnAlignment(2, AlignRight)
+	tbl.SetColumnPercentOf(1, 0, 0)
+	tbl.AddFooterAggregate(2, AggSum)
+	tbl.GroupBy(1, map[int]Aggregator{2: AggMax})
+	tbl.SetColumnWidths([]int{4, 1, 5})
+	if err := tbl.ReorderColumns([]int{2, 0, 1}); err != nil {
+		t.Fatalf("Table.ReorderColumns() error = %v", err)
+	}
+	if want := [][]string{{"price", "name", "n"}, {"2.5", "foo", "1"}, {"0.5", "bar", "2"}}; !reflect.DeepEqual(tbl.rows.all(), want) {
+		t.Errorf("Table.ReorderColumns().rows -> %v, want %v", tbl.rows.all(), want)
+	}
+	if got := tbl.columnAlignment(0); got != AlignRight {
+		t.Errorf("Table.ReorderColumns() alignment of column 0 -> %v, want %v", got, AlignRight)
+	}
+	if p := tbl.column(2).percent; p == nil || p.denominator != 1 {
+		t.Errorf("Table.ReorderColumns() percent of column 2 -> %v, want denominator 1", p)
+	}
+	if want := map[int]Aggregator{0: AggSum}; !reflect.DeepEqual(tbl.footer, want) {
+		t.Errorf("Table.ReorderColumns().footer -> %v, want %v", tbl.footer, want)
+	}
+	if want := (&groupSettings{col: 2, aggregators: map[int]Aggregator{0: AggMax}}); !reflect.DeepEqual(tbl.groupBy, want) {
+		t.Errorf("Table.ReorderColumns().groupBy -> %v, want %v", tbl.groupBy, want)
+	}
+	if want := []int{5, 4, 1}; !reflect.DeepEqual(tbl.fixedWidths, want) {
+		t.Errorf("Table.ReorderColumns().fixedWidths -> %v, want %v", tbl.fixedWidths, want)
+	}
+	for _, order := range [][]int{{0, 1}, {0, 1, 1}, {0, 1, 3}} {
+		if err := tbl.ReorderColumns(order); err == nil {
+			t.Errorf("Table.ReorderColumns(%v) error = nil, want error", order)
+		}
+	}
+	if err := tbl.ReorderColumnsByName([]string{"name", "n", "price"}); err != nil {
+		t.Fatalf("Table.ReorderColumnsByName() error = %v", err)
+	}
+	if want := []string{"name", "n", "price"}; !reflect.DeepEqual(tbl.rows.at(0).cells, want) {
+		t.Errorf("Table.ReorderColumnsByName() header -> %v, want %v", tbl.rows.at(0).cells, want)
+	}
+	if err := tbl.ReorderColumnsByName([]string{"name", "n", "missing"}); err == nil {
+		t.Errorf("Table.ReorderColumnsByName() error = nil, want error")
+	}
+}
+
+func TestTable_Transpose(t *testing.T) {
+	tests := []struct {
+		name            string
+		numLabelLevels  int
+		wantRows        [][]string
+		wantHeaderRows  int
+		wantLabelLevels int
+	}{
+		{"headers only", 0, [][]string{{"name", "foo", "bar"}, {"n", "1", "2"}}, 0, 1},
+		{"headers and labels", 1, [][]string{{"name", "foo", "bar"}, {"n", "1", "2"}}, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"name", "n"}, [][]string{{"foo", "1"}})
+			tbl.AppendStyledRow([]Cell{{Text: "bar"}, {Text: "2", Style: Style{Bold: true}}})
+			tbl.SetLabelLevelCount(tt.numLabelLevels)
+			tbl.Transpose()
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.Transpose().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+			if tbl.numHeaderRows != tt.wantHeaderRows || tbl.numLabelLevels != tt.wantLabelLevels {
+				t.Errorf("Table.Transpose() -> %d header rows and %d label levels, want %d and %d",
+					tbl.numHeaderRows, tbl.numLabelLevels, tt.wantHeaderRows, tt.wantLabelLevels)
+			}
+			if want := []Style{{}, {}, {Bold: true}}; !reflect.DeepEqual(tbl.rows.at(1).styles, want) {
+				t.Errorf("Table.Transpose().styles -> %v, want %v", tbl.rows.at(1).styles, want)
+			}
+		})
+	}
+}
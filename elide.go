@@ -0,0 +1,63 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SetMaxRows renders at most `n` non-header rows (default: no limit), so that accidentally huge tables don't flood the terminal.
+// If the table has more than `n` non-header rows, the first n/2 (rounded up) and the last n/2 are rendered,
+// separated by an elision row whose first cell reads e.g., "… 1,234 more rows …" and whose other cells read "…".
+// Footers, groups, and percentages are computed from every row. Use 0 to render every row.
+func (tbl *Table) SetMaxRows(n int) {
+	if n < 0 {
+		n = 0
+	}
+	tbl.maxRows = n
+}
+
+// elideRows returns a copy of `rows` with all but the first and last `maxRows/2` non-header rows replaced by an elision row,
+// the dividers remapped to the remaining rows, and the position of the elision row in `rows`.
+// Dividers within the elided rows are dropped.
+func elideRows(rows rowStore, dividers []int, numHeaderRows int, maxRows int) (rowStore, []int, int) {
+	numRows := rows.len() - numHeaderRows
+	tail := maxRows / 2
+	head := maxRows - tail
+	elided := numRows - maxRows
+	var ret rowStore
+	ret.grow(numHeaderRows + maxRows + 1)
+	for i := 0; i < numHeaderRows+head; i++ {
+		ret.push(rows.at(i))
+	}
+	elision := ret.len()
+	ret.push(elisionRow(len(rows.at(0).cells), elided))
+	for i := rows.len() - tail; i < rows.len(); i++ {
+		ret.push(rows.at(i))
+	}
+	var retDividers []int
+	for _, d := range dividers {
+		switch {
+		case d <= head:
+			retDividers = append(retDividers, d)
+		case d >= numRows-tail:
+			retDividers = append(retDividers, d-elided+1)
+		}
+	}
+	return ret, retDividers, elision
+}
+
+// elisionRow returns the row with `numCols` cells that replaces `n` elided rows.
+func elisionRow(numCols int, n int) record {
+	cells := make([]string, numCols)
+	for k := range cells {
+		cells[k] = "…"
+	}
+	noun := "rows"
+	if n == 1 {
+		noun = "row"
+	}
+	if numCols > 0 {
+		cells[0] = fmt.Sprintf("… %s more %s …", groupThousands(strconv.Itoa(n)), noun)
+	}
+	return record{cells: cells}
+}
@@ -0,0 +1,97 @@
+package tablewriter
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestTable_SetMaxRows(t *testing.T) {
+	rows := make([][]string, 1234+4)
+	for i := range rows {
+		rows[i] = []string{strconv.Itoa(i), "x"}
+	}
+	tests := []struct {
+		name    string
+		maxRows int
+		setup   func(tbl *Table)
+		want    string
+	}{
+		{"even", 4, nil, "" +
+			"+---------------------+---+\n" +
+			"|          a          | b |\n" +
+			"|---------------------|---|\n" +
+			"|          0          | x |\n" +
+			"|          1          | x |\n" +
+			"| … 1,234 more rows … | … |\n" +
+			"|        1236         | x |\n" +
+			"|        1237         | x |\n" +
+			"+---------------------+---+\n"},
+		{"odd", 3, nil, "" +
+			"+---------------------+---+\n" +
+			"|          a          | b |\n" +
+			"|---------------------|---|\n" +
+			"|          0          | x |\n" +
+			"|          1          | x |\n" +
+			"| … 1,235 more rows … | … |\n" +
+			"|        1237         | x |\n" +
+			"+---------------------+---+\n"},
+		{"auto index", 2, func(tbl *Table) { tbl.EnableAutoIndex() }, "" +
+			"+------++---------------------+---+\n" +
+			"|      ||          a          | b |\n" +
+			"|------||---------------------|---|\n" +
+			"|  1   ||          0          | x |\n" +
+			"|  …   || … 1,236 more rows … | … |\n" +
+			"| 1238 ||        1237         | x |\n" +
+			"+------++---------------------+---+\n"},
+		{"footer counts every row", 2, func(tbl *Table) { tbl.AddFooterAggregate(0, AggCount) }, "" +
+			"+---------------------+---+\n" +
+			"|          a          | b |\n" +
+			"|---------------------|---|\n" +
+			"|          0          | x |\n" +
+			"| … 1,236 more rows … | … |\n" +
+			"|        1237         | x |\n" +
+			"+---------------------+---+\n" +
+			"|        1238         |   |\n" +
+			"+---------------------+---+\n"},
+		{"no limit", 5000, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"a", "b"}, rows)
+			if tt.setup != nil {
+				tt.setup(tbl)
+			}
+			tbl.SetMaxRows(tt.maxRows)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if tt.want == "" {
+				tbl.SetMaxRows(0)
+				tt.want, _ = tbl.render()
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_elideRows(t *testing.T) {
+	var rows rowStore
+	for i := 0; i < 11; i++ {
+		rows.push(record{cells: []string{strconv.Itoa(i)}})
+	}
+	got, dividers, elision := elideRows(rows, []int{1, 5, 8, 9}, 1, 4)
+	if got.len() != 6 || got.at(5).cells[0] != "10" {
+		t.Errorf("elideRows() rows = %v, want 6 rows ending with 10", got.all())
+	}
+	if elision != 3 {
+		t.Errorf("elideRows() elision = %v, want 3", elision)
+	}
+	want := []int{1, 3, 4}
+	if !reflect.DeepEqual(dividers, want) {
+		t.Errorf("elideRows() dividers = %v, want %v", dividers, want)
+	}
+}
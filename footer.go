@@ -0,0 +1,30 @@
+package tablewriter
+
+// AddFooterAggregate summarizes the non-header cells in column `col` with `agg` at render time,
+// and displays the result in a footer row below the body rows, separated from them by a divider.
+// Values are parsed as numbers, tolerating formatted values (e.g., " 1,234.5 "), and aggregates are rounded to 2 decimal places.
+// Each column has at most one aggregate, and calling AddFooterAggregate again for the same column replaces it.
+func (tbl *Table) AddFooterAggregate(col int, agg Aggregator) {
+	if tbl.footer == nil {
+		tbl.footer = make(map[int]Aggregator)
+	}
+	tbl.footer[col] = agg
+}
+
+// footerRow returns the footer row for the non-header rows in `rows`.
+func (tbl *Table) footerRow(rows rowStore) record {
+	numCols := len(rows.at(0).cells)
+	cells := make([]string, numCols)
+	values := make([]string, 0, rows.len()-tbl.numHeaderRows)
+	for k, agg := range tbl.footer {
+		if k < 0 || k >= numCols {
+			continue
+		}
+		values = values[:0]
+		for i := tbl.numHeaderRows; i < rows.len(); i++ {
+			values = append(values, rows.at(i).cells[k])
+		}
+		cells[k] = aggregate(agg, values, tbl.columnType(k))
+	}
+	return record{cells: cells}
+}
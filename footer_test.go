@@ -0,0 +1,49 @@
+package tablewriter
+
+import "testing"
+
+func TestTable_AddFooterAggregate(t *testing.T) {
+	tbl := newTestTable([]string{"name", "n", "price"}, [][]string{
+		{"foo", "1,000", "2.5"},
+		{"bar", "20", "n/a"},
+		{"baz", "3", "0.5"},
+	})
+	tbl.AddFooterAggregate(1, AggSum)
+	tbl.AddFooterAggregate(2, AggMin)
+	tbl.AddFooterAggregate(0, AggCount)
+	tbl.AddFooterAggregate(2, AggMax)
+	tbl.AppendDivider()
+	want := "" +
+		"+------+-------+-------+\n" +
+		"| name |   n   | price |\n" +
+		"|------|-------|-------|\n" +
+		"| foo  | 1,000 |  2.5  |\n" +
+		"| bar  |  20   |  n/a  |\n" +
+		"| baz  |   3   |  0.5  |\n" +
+		"+------+-------+-------+\n" +
+		"|  3   | 1023  |  2.5  |\n" +
+		"+------+-------+-------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	if tbl.rows.len() != 4 || len(tbl.dividers) != 1 {
+		t.Errorf("Table.AddFooterAggregate() changed stored rows or dividers")
+	}
+}
+
+func Test_aggregate_minMax(t *testing.T) {
+	values := []string{" 1,234.5 ", "-2", "", "foo"}
+	if got := aggregate(AggMin, values, TypeString); got != "-2" {
+		t.Errorf("aggregate(AggMin) = %v, want -2", got)
+	}
+	if got := aggregate(AggMax, values, TypeString); got != "1234.5" {
+		t.Errorf("aggregate(AggMax) = %v, want 1234.5", got)
+	}
+	if got := aggregate(AggMax, nil, TypeString); got != "" {
+		t.Errorf("aggregate(AggMax) of no values = %v, want empty", got)
+	}
+}
@@ -0,0 +1,231 @@
+package tablewriter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderAsciiDoc creates an AsciiDoc representation of the table (a |=== block) and writes the results into the table's io.Writer.
+// Any metadata shown with ShowMetadata is written as comment lines ("// key: value").
+// Column alignment is preserved in the block's cols attribute.
+// AsciiDoc tables have a single header row, so multiple header rows are joined into one, separated by spaces.
+// Render-time transformations (e.g., auto index and column formats) are applied, but ANSI styles are not.
+func (tbl *Table) RenderAsciiDoc() error {
+	s, err := tbl.stringifyAsciiDoc()
+	if err != nil {
+		return fmt.Errorf("tbl.RenderAsciiDoc(): %v", err)
+	}
+	_, err = tbl.w.Write(tbl.withLineEndings([]byte(s)))
+	if err != nil {
+		return fmt.Errorf("tbl.RenderAsciiDoc(): %v", err)
+	}
+	return nil
+}
+
+// RenderReST creates a reStructuredText grid table and writes the results into the table's io.Writer.
+// Any metadata shown with ShowMetadata is written as comment lines (".. key: value"), separated from the table by a blank line.
+// Every column is as wide as its widest cell, and text is aligned within each cell as in Render.
+// Cells with line breaks span multiple lines of their row.
+// Render-time transformations (e.g., auto index and column formats) are applied, but ANSI styles are not.
+func (tbl *Table) RenderReST() error {
+	s, err := tbl.stringifyReST()
+	if err != nil {
+		return fmt.Errorf("tbl.RenderReST(): %v", err)
+	}
+	_, err = tbl.w.Write(tbl.withLineEndings([]byte(s)))
+	if err != nil {
+		return fmt.Errorf("tbl.RenderReST(): %v", err)
+	}
+	return nil
+}
+
+// RenderOrg creates an Org-mode table and writes the results into the table's io.Writer.
+// Any metadata shown with ShowMetadata is written as comment lines ("# key: value").
+// A "|" within a cell is escaped as "\vert{}", so that it does not start a new column.
+// Org-mode tables have no multi-line cells, so each additional line of a cell with line breaks is written as another table line.
+// Every column is as wide as its widest cell, and text is aligned within each cell as in Render.
+// Render-time transformations (e.g., auto index and column formats) are applied, but ANSI styles are not.
+func (tbl *Table) RenderOrg() error {
+	s, err := tbl.stringifyOrg()
+	if err != nil {
+		return fmt.Errorf("tbl.RenderOrg(): %v", err)
+	}
+	_, err = tbl.w.Write(tbl.withLineEndings([]byte(s)))
+	if err != nil {
+		return fmt.Errorf("tbl.RenderOrg(): %v", err)
+	}
+	return nil
+}
+
+func (tbl *Table) stringifyAsciiDoc() (string, error) {
+	if err := tbl.checkRenderable(); err != nil {
+		return "", err
+	}
+	tbl = tbl.plainView()
+	numCols := len(tbl.rows.at(0).cells)
+	ret := strings.Builder{}
+	specs := make([]string, numCols)
+	for k := range specs {
+		specs[k] = asciiDocAlignment(tbl.columnAlignment(k))
+	}
+	fmt.Fprintf(&ret, "[cols=%q", strings.Join(specs, ","))
+	if tbl.numHeaderRows > 0 {
+		ret.WriteString(`,options="header"`)
+	}
+	ret.WriteString("]\n|===\n")
+	if tbl.numHeaderRows > 0 {
+		header := make([]string, numCols)
+		for k := range header {
+			var parts []string
+			for i := 0; i < tbl.numHeaderRows; i++ {
+				if cell := tbl.rows.at(i).cells[k]; cell != "" {
+					parts = append(parts, cell)
+				}
+			}
+			header[k] = strings.Join(parts, " ")
+		}
+		writeAsciiDocRow(&ret, header)
+	}
+	for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+		writeAsciiDocRow(&ret, tbl.rows.at(i).cells)
+	}
+	ret.WriteString("|===\n")
+	return tbl.withMetadata(ret.String(), "// ", ""), nil
+}
+
+// |foo |bar
+func writeAsciiDocRow(b *strings.Builder, cells []string) {
+	for k, cell := range cells {
+		if k > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteByte('|')
+		b.WriteString(strings.ReplaceAll(cell, "|", `\|`))
+	}
+	b.WriteByte('\n')
+}
+
+func asciiDocAlignment(alignment Alignment) string {
+	switch alignment {
+	case AlignLeft:
+		return "<"
+	case AlignRight:
+		return ">"
+	default:
+		return "^"
+	}
+}
+
+func (tbl *Table) stringifyReST() (string, error) {
+	if err := tbl.checkRenderable(); err != nil {
+		return "", err
+	}
+	tbl = tbl.plainView()
+	colWidths := tbl.naturalColWidths(nil)
+	border := stringifyReSTDivider(colWidths, '-')
+	ret := strings.Builder{}
+	ret.WriteString(border)
+	for i := 0; i < tbl.rows.len(); i++ {
+		tbl.writePlainRow(&ret, colWidths, i, nil)
+		// grid tables separate every row, and mark the end of the header rows with '='
+		if i == tbl.numHeaderRows-1 && i < tbl.rows.len()-1 {
+			ret.WriteString(stringifyReSTDivider(colWidths, '='))
+		} else {
+			ret.WriteString(border)
+		}
+	}
+	// a blank line ends the comment block
+	return tbl.withMetadata(ret.String(), ".. ", "\n"), nil
+}
+
+// [3,1] -> +-----+---+
+func stringifyReSTDivider(colWidths []int, filler byte) string {
+	ret := strings.Builder{}
+	ret.WriteByte('+')
+	for _, width := range colWidths {
+		ret.WriteString(strings.Repeat(string(filler), 1+width+1))
+		ret.WriteByte('+')
+	}
+	ret.WriteByte('\n')
+	return ret.String()
+}
+
+// | foo | bar |
+// |-----+-----|
+func (tbl *Table) stringifyOrg() (string, error) {
+	if err := tbl.checkRenderable(); err != nil {
+		return "", err
+	}
+	tbl = tbl.plainView()
+	colWidths := tbl.naturalColWidths(orgEscaper.Replace)
+	ret := strings.Builder{}
+	for i := 0; i < tbl.rows.len(); i++ {
+		tbl.writePlainRow(&ret, colWidths, i, orgEscaper.Replace)
+		if i == tbl.numHeaderRows-1 && i < tbl.rows.len()-1 {
+			ret.WriteByte('|')
+			for k, width := range colWidths {
+				if k > 0 {
+					ret.WriteByte('+')
+				}
+				ret.WriteString(strings.Repeat("-", 1+width+1))
+			}
+			ret.WriteString("|\n")
+		}
+	}
+	return tbl.withMetadata(ret.String(), "# ", ""), nil
+}
+
+// orgEscaper escapes the column separator within Org-mode cells.
+var orgEscaper = strings.NewReplacer("|", `\vert{}`)
+
+// naturalColWidths returns the width of the widest line of any cell in each column, without regard to the maximum column width.
+// If `escape` is not nil, cells are measured as escaped by `escape`.
+func (tbl *Table) naturalColWidths(escape func(string) string) []int {
+	colWidths := make([]int, len(tbl.rows.at(0).cells))
+	for i := 0; i < tbl.rows.len(); i++ {
+		for k, cell := range tbl.rows.at(i).cells {
+			if escape != nil {
+				cell = escape(cell)
+			}
+			if w := blockWidth(cell); w > colWidths[k] {
+				colWidths[k] = w
+			}
+		}
+	}
+	return colWidths
+}
+
+// writePlainRow writes row `i` as aligned cells separated by '|', without wrapping, truncation, or styling.
+// A cell with line breaks continues on the following lines, and the other cells in the row are blank on those lines.
+// If `escape` is not nil, every cell is escaped by `escape` first.
+func (tbl *Table) writePlainRow(b *strings.Builder, colWidths []int, i int, escape func(string) string) {
+	header := i < tbl.numHeaderRows
+	cells := tbl.rows.at(i).cells
+	lines := make([][]string, len(cells))
+	numLines := 1
+	for k, cell := range cells {
+		if escape != nil {
+			cell = escape(cell)
+		}
+		lines[k] = strings.Split(cell, "\n")
+		if len(lines[k]) > numLines {
+			numLines = len(lines[k])
+		}
+	}
+	for n := 0; n < numLines; n++ {
+		b.WriteByte('|')
+		for k := range cells {
+			var line string
+			if n < len(lines[k]) {
+				line = lines[k][n]
+			}
+			alignment := tbl.columnAlignment(k)
+			if header && tbl.autoCenterHeaders {
+				alignment = AlignCenter
+			}
+			b.WriteString(alignString(line, colWidths[k], alignment))
+			b.WriteByte('|')
+		}
+		b.WriteByte('\n')
+	}
+}
@@ -0,0 +1,146 @@
+package tablewriter
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTable_RenderAsciiDoc(t *testing.T) {
+	tests := []struct {
+		name          string
+		headers       [][]string
+		rows          [][]string
+		alignment     Alignment
+		columnAligned bool
+		want          string
+	}{
+		{"no headers", nil, [][]string{{"a", "b|c"}}, AlignCenter, false, "" +
+			"[cols=\"^,^\"]\n" +
+			"|===\n" +
+			"|a |b\\|c\n" +
+			"|===\n"},
+		{"headers and alignment", [][]string{{"foo", ""}, {"bar", "baz"}}, [][]string{{"1", "2"}}, AlignLeft, true, "" +
+			"[cols=\"<,>\",options=\"header\"]\n" +
+			"|===\n" +
+			"|foo bar |baz\n" +
+			"|1 |2\n" +
+			"|===\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tbl := NewTable(&buf)
+			for _, header := range tt.headers {
+				tbl.AppendHeaderRow(header)
+			}
+			tbl.AppendRows(tt.rows)
+			tbl.SetAlignment(tt.alignment)
+			if tt.columnAligned {
+				tbl.SetColumnAlignment(1, AlignRight)
+			}
+			if err := tbl.RenderAsciiDoc(); err != nil {
+				t.Fatalf("Table.RenderAsciiDoc() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Table.RenderAsciiDoc() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_RenderReST(t *testing.T) {
+	var buf bytes.Buffer
+	tbl := NewTable(&buf)
+	tbl.AppendHeaderRow([]string{"name", "n"})
+	tbl.AppendRows([][]string{{"foo", "1"}, {"barbaz", "10"}})
+	tbl.SetAlignment(AlignLeft)
+	tbl.SetColumnAlignment(1, AlignRight)
+	want := "" +
+		"+--------+----+\n" +
+		"|  name  | n  |\n" +
+		"+========+====+\n" +
+		"| foo    |  1 |\n" +
+		"+--------+----+\n" +
+		"| barbaz | 10 |\n" +
+		"+--------+----+\n"
+	if err := tbl.RenderReST(); err != nil {
+		t.Fatalf("Table.RenderReST() error = %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("Table.RenderReST() -> %v, want %v", got, want)
+	}
+	if err := NewTable(&buf).RenderReST(); err == nil {
+		t.Errorf("Table.RenderReST() on empty table returned nil error, want error")
+	}
+}
+
+func TestTable_RenderOrg(t *testing.T) {
+	var buf bytes.Buffer
+	tbl := NewTable(&buf)
+	tbl.AppendHeaderRow([]string{"name", "n"})
+	tbl.AppendRows([][]string{{"foo", "1"}, {"barbaz", "10"}})
+	tbl.SetAlignment(AlignLeft)
+	tbl.SetColumnAlignment(1, AlignRight)
+	want := "" +
+		"|  name  | n  |\n" +
+		"|--------+----|\n" +
+		"| foo    |  1 |\n" +
+		"| barbaz | 10 |\n"
+	if err := tbl.RenderOrg(); err != nil {
+		t.Fatalf("Table.RenderOrg() error = %v", err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("Table.RenderOrg() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_RenderOrg_escapesSeparators(t *testing.T) {
+	tbl := newTestTable([]string{"expr"}, [][]string{{"a | b"}})
+	tbl.SetAlignment(AlignLeft)
+	want := "" +
+		"|    expr     |\n" +
+		"|-------------|\n" +
+		"| a \\vert{} b |\n"
+	got, err := tbl.stringifyOrg()
+	if err != nil {
+		t.Fatalf("Table.stringifyOrg() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.stringifyOrg() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_plainFormats_multiline(t *testing.T) {
+	tests := []struct {
+		name      string
+		stringify func(*Table) (string, error)
+		want      string
+	}{
+		{"rest", (*Table).stringifyReST, "" +
+			"+---+-------+\n" +
+			"| a | b     |\n" +
+			"+===+=======+\n" +
+			"| 1 | line1 |\n" +
+			"|   | line2 |\n" +
+			"+---+-------+\n"},
+		{"org", (*Table).stringifyOrg, "" +
+			"| a | b     |\n" +
+			"|---+-------|\n" +
+			"| 1 | line1 |\n" +
+			"|   | line2 |\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"a", "b"}, [][]string{{"1", "line1\nline2"}})
+			tbl.SetAlignment(AlignLeft)
+			tbl.DisableHeaderAutoCentering()
+			got, err := tt.stringify(tbl)
+			if err != nil {
+				t.Fatalf("stringify() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("stringify() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package tablewriter
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+// An Aggregator summarizes the values in a column.
+// Values are parsed as numbers (tolerating surrounding whitespace and thousands separators), and cells that are not numbers are ignored.
+type Aggregator int
+
+const (
+	// AggSum is the sum of the values.
+	AggSum Aggregator = iota
+	// AggCount is the number of non-empty cells.
+	AggCount
+	// AggAvg is the mean of the values.
+	AggAvg
+	// AggMin is the smallest value.
+	AggMin
+	// AggMax is the largest value.
+	AggMax
+)
+
+// groupSettings configures render-time grouping.
+type groupSettings struct {
+	col         int
+	aggregators map[int]Aggregator
+}
+
+// GroupBy groups the non-header rows by the values in column `col` at render time:
+// groups are sorted by their key (numerically, if both keys are numbers), repeated keys within each group are blanked,
+// and a divider is drawn between groups.
+// If `agg` is not empty, a subtotal row follows each group and a grand total row ends the table,
+// with each column in `agg` summarized by its Aggregator. Aggregates are rounded to 2 decimal places.
+// Stored rows are not modified.
+func (tbl *Table) GroupBy(col int, agg map[int]Aggregator) {
+	settings := &groupSettings{col: col, aggregators: make(map[int]Aggregator, len(agg))}
+	for k, a := range agg {
+		settings.aggregators[k] = a
+	}
+	tbl.groupBy = settings
+}
+
+// groupRows returns a copy of `rows` with the non-header rows grouped as configured by GroupBy,
+// along with the positions of the dividers between groups.
+func (tbl *Table) groupRows(rows rowStore) (rowStore, []int) {
+	var ret rowStore
+	for i := 0; i < tbl.numHeaderRows; i++ {
+		ret.push(rows.at(i))
+	}
+	col := tbl.groupBy.col
+	typ := tbl.columnType(col)
+	body := make([]record, 0, rows.len()-tbl.numHeaderRows)
+	for i := tbl.numHeaderRows; i < rows.len(); i++ {
+		if r := rows.at(i); col >= 0 && col < len(r.cells) {
+			body = append(body, r)
+		}
+	}
+	if len(body) == 0 {
+		return copyRows(rows), nil
+	}
+	sort.SliceStable(body, func(i, j int) bool {
+		return typ.less(body[i].cells[col], body[j].cells[col])
+	})
+	var dividers []int
+	aggregate := len(tbl.groupBy.aggregators) > 0
+	for start := 0; start < len(body); {
+		end := start + 1
+		for end < len(body) && body[end].cells[col] == body[start].cells[col] {
+			end++
+		}
+		ret.push(body[start])
+		for _, r := range body[start+1 : end] {
+			merged := record{cells: make([]string, len(r.cells)), styles: r.styles, tags: r.tags}
+			copy(merged.cells, r.cells)
+			merged.cells[col] = ""
+			ret.push(merged)
+		}
+		if aggregate {
+			ret.push(tbl.aggregateRow(body[start:end], "subtotal"))
+		}
+		dividers = append(dividers, ret.len()-tbl.numHeaderRows)
+		start = end
+	}
+	if aggregate {
+		ret.push(tbl.aggregateRow(body, "total"))
+	}
+	return ret, dividers
+}
+
+// aggregateRow returns a row with the column that rows are grouped by set to `label`
+// and each aggregated column set to the aggregate of `rows`.
+func (tbl *Table) aggregateRow(rows []record, label string) record {
+	cells := make([]string, len(rows[0].cells))
+	cells[tbl.groupBy.col] = label
+	for k, agg := range tbl.groupBy.aggregators {
+		if k < 0 || k >= len(cells) || k == tbl.groupBy.col {
+			continue
+		}
+		values := make([]string, len(rows))
+		for i := range rows {
+			values[i] = rows[i].cells[k]
+		}
+		cells[k] = aggregate(agg, values, tbl.columnType(k))
+	}
+	return record{cells: cells}
+}
+
+// aggregate summarizes `values`, parsed and formatted as `typ`, with `agg`. Averages, minimums, and maximums of no numbers are empty.
+func aggregate(agg Aggregator, values []string, typ ColumnType) string {
+	var sum float64
+	var count, numbers int
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if v != "" {
+			count++
+		}
+		if f, ok := typ.parse(v); ok {
+			sum += f
+			numbers++
+			min = math.Min(min, f)
+			max = math.Max(max, f)
+		}
+	}
+	switch agg {
+	case AggCount:
+		return strconv.Itoa(count)
+	case AggAvg, AggMin, AggMax:
+		if numbers == 0 {
+			return ""
+		}
+		if agg == AggMin {
+			return typ.formatValue(min)
+		}
+		if agg == AggMax {
+			return typ.formatValue(max)
+		}
+		return typ.formatValue(sum / float64(numbers))
+	default:
+		return typ.formatValue(sum)
+	}
+}
+
+// formatAggregate rounds `f` to 2 decimal places, without trailing zeros.
+func formatAggregate(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,95 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTable_GroupBy(t *testing.T) {
+	tests := []struct {
+		name string
+		agg  map[int]Aggregator
+		want string
+	}{
+		{"no aggregates", nil, "" +
+			"+------+------+-----+\n" +
+			"| team | name | pts |\n" +
+			"|------|------|-----|\n" +
+			"|  a   | foo  |  1  |\n" +
+			"|      | qux  |  4  |\n" +
+			"+------+------+-----+\n" +
+			"|  b   | bar  |  2  |\n" +
+			"|      | baz  | 3.5 |\n" +
+			"+------+------+-----+\n"},
+		{"subtotals", map[int]Aggregator{1: AggCount, 2: AggSum}, "" +
+			"+----------+------+------+\n" +
+			"|   team   | name | pts  |\n" +
+			"|----------|------|------|\n" +
+			"|    a     | foo  |  1   |\n" +
+			"|          | qux  |  4   |\n" +
+			"| subtotal |  2   |  5   |\n" +
+			"+----------+------+------+\n" +
+			"|    b     | bar  |  2   |\n" +
+			"|          | baz  | 3.5  |\n" +
+			"| subtotal |  2   | 5.5  |\n" +
+			"+----------+------+------+\n" +
+			"|  total   |  4   | 10.5 |\n" +
+			"+----------+------+------+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"team", "name", "pts"}, [][]string{
+				{"b", "bar", "2"}, {"a", "foo", "1"}, {"b", "baz", "3.5"}, {"a", "qux", "4"},
+			})
+			tbl.GroupBy(0, tt.agg)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+			if stored := tbl.rows.at(1).cells[0]; stored != "b" {
+				t.Errorf("Table.GroupBy() changed stored rows -> %v, want b", stored)
+			}
+		})
+	}
+}
+
+func TestTable_GroupBy_missingColumn(t *testing.T) {
+	tbl := newTestTable([]string{"key", "val"}, [][]string{{"a", "1"}, {"b", "2"}})
+	tbl.GroupBy(5, nil)
+	if err := tbl.SetHeaderSubtext([]string{"str", "int"}); err != nil {
+		t.Fatalf("Table.SetHeaderSubtext() error = %v", err)
+	}
+	for n := 0; n < 2; n++ {
+		if _, err := tbl.render(); err != nil {
+			t.Fatalf("Table.render() error = %v", err)
+		}
+	}
+	if got, want := tbl.Rows(), [][]string{{"a", "1"}, {"b", "2"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.GroupBy() changed stored rows -> %v, want %v", got, want)
+	}
+}
+
+func Test_aggregate(t *testing.T) {
+	tests := []struct {
+		name   string
+		agg    Aggregator
+		values []string
+		want   string
+	}{
+		{"sum", AggSum, []string{"1", "2,000", "n/a", ""}, "2001"},
+		{"sum - rounded", AggSum, []string{"0.1", "0.2"}, "0.3"},
+		{"count", AggCount, []string{"1", "", "n/a"}, "2"},
+		{"avg", AggAvg, []string{"1", "2", "4"}, "2.33"},
+		{"avg - no numbers", AggAvg, []string{"n/a"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := aggregate(tt.agg, tt.values, TypeString); got != tt.want {
+				t.Errorf("aggregate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tablewriter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Markers that surround highlighted text when the highlight style has no ANSI attributes.
+const (
+	highlightOpen  = "["
+	highlightClose = "]"
+)
+
+// highlight marks the substrings of non-header cells that match a pattern.
+type highlight struct {
+	pattern *regexp.Regexp
+	style   Style
+}
+
+// Highlight marks every substring of a non-header cell that matches the regular expression `pattern` at render time,
+// for building grep-like views over tabular data (e.g., `tbl.Highlight("(?i)error", Style{Foreground: ColorRed})`).
+// Matches are rendered with `style` layered over the style of the cell, which resumes after each match.
+// If `style` has no ANSI attributes (e.g., Style{}), matches are surrounded by "[" and "]" instead,
+// and column widths include the markers.
+// Only Render marks matches: RenderAsciiDoc, RenderReST, RenderOrg, RenderWith, and Snapshot write the plain text of each cell.
+// Index cells are never highlighted. An empty pattern removes any existing highlight.
+func (tbl *Table) Highlight(pattern string, style Style) error {
+	if pattern == "" {
+		tbl.highlight = nil
+		return nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("tbl.Highlight(): %v", err)
+	}
+	tbl.highlight = &highlight{pattern: re, style: style}
+	return nil
+}
+
+// apply returns `cell` with every non-empty match of the pattern marked, given the `base` style of the cell.
+func (h *highlight) apply(cell string, base Style) string {
+	matches := h.pattern.FindAllStringIndex(cell, -1)
+	if len(matches) == 0 {
+		return cell
+	}
+	prefix, suffix := highlightOpen, highlightClose
+	if codes := h.style.over(base).sgrCodes(); len(codes) > 0 {
+		prefix = sgrPrefix + strings.Join(codes, ";") + sgrSuffix
+		suffix = sgrReset
+		if codes := base.sgrCodes(); len(codes) > 0 {
+			suffix += sgrPrefix + strings.Join(codes, ";") + sgrSuffix
+		}
+	}
+	var b strings.Builder
+	var last int
+	for _, m := range matches {
+		if m[0] == m[1] {
+			continue
+		}
+		b.WriteString(cell[last:m[0]])
+		b.WriteString(prefix)
+		b.WriteString(cell[m[0]:m[1]])
+		b.WriteString(suffix)
+		last = m[1]
+	}
+	b.WriteString(cell[last:])
+	return b.String()
+}
+
+// withHighlight returns `row`, or a copy of `row` with matches of `h` marked in every cell except the cell at `skip`.
+func withHighlight(row record, h *highlight, skip int) record {
+	var cells []string
+	for k := range row.cells {
+		if k == skip {
+			continue
+		}
+		var base Style
+		if row.styles != nil {
+			base = row.styles[k]
+		}
+		cell := h.apply(row.cells[k], base)
+		if cell == row.cells[k] {
+			continue
+		}
+		if cells == nil {
+			cells = make([]string, len(row.cells))
+			copy(cells, row.cells)
+		}
+		cells[k] = cell
+	}
+	if cells == nil {
+		return row
+	}
+	return record{cells: cells, styles: row.styles}
+}
@@ -0,0 +1,106 @@
+package tablewriter
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func Test_highlight_apply(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		style   Style
+		base    Style
+		cell    string
+		want    string
+	}{
+		{"no match", "x", Style{Underline: true}, Style{}, "foo", "foo"},
+		{"ansi", "o", Style{Underline: true}, Style{}, "foo", "f\x1b[4mo\x1b[0m\x1b[4mo\x1b[0m"},
+		{"ansi resumes base style", "b", Style{Foreground: ColorRed}, Style{Bold: true}, "abc", "a\x1b[1;31mb\x1b[0m\x1b[1mc"},
+		{"plain markers", "ba.", Style{}, Style{}, "foo bar baz", "foo [bar] [baz]"},
+		{"plain markers with text-only style", "o+", Style{Uppercase: true}, Style{}, "foo", "f[oo]"},
+		{"empty matches ignored", "x*", Style{}, Style{}, "foo", "foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &highlight{pattern: regexp.MustCompile(tt.pattern), style: tt.style}
+			if got := h.apply(tt.cell, tt.base); got != tt.want {
+				t.Errorf("highlight.apply() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_Highlight(t *testing.T) {
+	tbl := newTestTable([]string{"level", "msg"}, [][]string{{"error", "disk error"}, {"info", "ok"}})
+	if err := tbl.Highlight("err(or)?", Style{}); err != nil {
+		t.Fatalf("Table.Highlight() error = %v", err)
+	}
+	tbl.EnableAutoIndex()
+	v := tbl.view()
+	want := [][]string{{"", "level", "msg"}, {"1", "[error]", "disk [error]"}, {"2", "info", "ok"}}
+	for i := range want {
+		if got := v.rows.at(i).cells; !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("Table.view().rows.at(%d).cells -> %v, want %v", i, got, want[i])
+		}
+	}
+
+	tbl.Highlight("", Style{})
+	if tbl.highlight != nil {
+		t.Errorf("Table.Highlight(\"\").highlight -> %v, want nil", tbl.highlight)
+	}
+	if err := tbl.Highlight("(", Style{}); err == nil {
+		t.Errorf("Table.Highlight() returned nil error for invalid pattern")
+	}
+}
+
+func TestTable_Highlight_wrapped(t *testing.T) {
+	tbl := newTestTable([]string{"msg"}, [][]string{{"café disk error here"}})
+	tbl.SetColumnAlignment(0, AlignLeft)
+	tbl.SetMaxColumnWidth(10)
+	if err := tbl.Highlight("disk error", Style{Bold: true}); err != nil {
+		t.Fatalf("Table.Highlight() error = %v", err)
+	}
+	want := "" +
+		"+------------+\n" +
+		"|    msg     |\n" +
+		"|------------|\n" +
+		"| café \x1b[1mdisk\x1b[0m  |\n" +
+		"| \x1b[1merror\x1b[0m here |\n" +
+		"+------------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %q, want %q", got, want)
+	}
+}
+
+func TestTable_Highlight_plainOutput(t *testing.T) {
+	tbl := newTestTable([]string{"msg"}, [][]string{{"error here"}})
+	if err := tbl.Highlight("error", Style{Foreground: ColorRed}); err != nil {
+		t.Fatalf("Table.Highlight() error = %v", err)
+	}
+	if got, want := tbl.Snapshot(), [][]string{{"msg"}, {"error here"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.Snapshot() -> %q, want %q", got, want)
+	}
+	got, err := tbl.stringifyOrg()
+	if err != nil {
+		t.Fatalf("Table.stringifyOrg() error = %v", err)
+	}
+	if want := "|    msg     |\n|------------|\n| error here |\n"; got != want {
+		t.Errorf("Table.stringifyOrg() -> %q, want %q", got, want)
+	}
+	model, err := tbl.Model()
+	if err != nil {
+		t.Fatalf("Table.Model() error = %v", err)
+	}
+	if want := [][]string{{"error here"}}; !reflect.DeepEqual(model.Rows, want) {
+		t.Errorf("Table.Model().Rows -> %q, want %q", model.Rows, want)
+	}
+	if tbl.highlight == nil {
+		t.Errorf("Table.Snapshot() removed the highlight")
+	}
+}
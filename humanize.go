@@ -0,0 +1,100 @@
+package tablewriter
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// A Humanizer formats the values in a column for people to read.
+type Humanizer int
+
+const (
+	// HumanizeNone leaves cells unchanged.
+	HumanizeNone Humanizer = iota
+	// HumanizeBytes formats sizes in bytes (e.g., "1288490189" or "1.2GB") with binary units (e.g., "1.2GiB").
+	HumanizeBytes
+	// HumanizeDuration formats durations (e.g., "3723s" or "3723", in seconds) as "1h2m3s".
+	HumanizeDuration
+	// HumanizeRelativeTime formats times (RFC 3339, "2006-01-02 15:04:05", "2006-01-02", or Unix seconds)
+	// relative to the time of rendering (e.g., "3 minutes ago" or "in 2 days").
+	HumanizeRelativeTime
+)
+
+// now returns the current time, and is replaced in tests.
+var now = time.Now
+
+// SetColumnHumanizer formats each non-header cell in column `col` (starting at 0) with `h` at render time.
+// Cells that cannot be parsed are left unchanged. Alignment, sorting, and aggregation are unaffected (see SetColumnType).
+// HumanizeNone removes any formatter from the column.
+func (tbl *Table) SetColumnHumanizer(col int, h Humanizer) {
+	tbl.updateColumn(col, func(c *columnSettings) {
+		switch h {
+		case HumanizeBytes:
+			c.format = TypeBytes.formatCell
+		case HumanizeDuration:
+			c.format = TypeDuration.formatCell
+		case HumanizeRelativeTime:
+			c.format = formatRelativeTime
+		default:
+			c.format = nil
+		}
+	})
+}
+
+// FormatBytes formats `n` bytes with the largest binary unit in which it is at least 1, with up to 1 decimal place (e.g., "1.2GiB").
+func FormatBytes(n int64) string {
+	return humanizeBytes(float64(n))
+}
+
+// FormatDuration formats `d`, rounded to the second if it is at least a second long (e.g., "1h2m3s").
+func FormatDuration(d time.Duration) string {
+	return humanizeDuration(d.Seconds())
+}
+
+// FormatRelativeTime formats `t` relative to `since` in the largest whole unit, from seconds to years
+// (e.g., "3 minutes ago" or "in 2 days"). Times less than a second apart are "just now".
+func FormatRelativeTime(t, since time.Time) string {
+	d := since.Sub(t)
+	if d > -time.Second && d < time.Second {
+		return "just now"
+	}
+	ago := d > 0
+	if !ago {
+		d = -d
+	}
+	var n int64
+	var unit string
+	switch {
+	case d < time.Minute:
+		n, unit = int64(d/time.Second), "second"
+	case d < time.Hour:
+		n, unit = int64(d/time.Minute), "minute"
+	case d < 24*time.Hour:
+		n, unit = int64(d/time.Hour), "hour"
+	case d < 30*24*time.Hour:
+		n, unit = int64(d/(24*time.Hour)), "day"
+	case d < 365*24*time.Hour:
+		n, unit = int64(d/(30*24*time.Hour)), "month"
+	default:
+		n, unit = int64(d/(365*24*time.Hour)), "year"
+	}
+	s := strconv.FormatInt(n, 10) + " " + unit
+	if n != 1 {
+		s += "s"
+	}
+	if ago {
+		return s + " ago"
+	}
+	return "in " + s
+}
+
+// formatRelativeTime formats the time `s` relative to now, or returns it unchanged if it cannot be parsed.
+func formatRelativeTime(s string) string {
+	f, ok := parseTime(s)
+	if !ok {
+		return s
+	}
+	sec, frac := math.Modf(f)
+	return FormatRelativeTime(time.Unix(int64(sec), int64(frac*1e9)), now())
+}
@@ -0,0 +1,82 @@
+package tablewriter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTable_SetColumnHumanizer(t *testing.T) {
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Date(2020, 3, 27, 15, 4, 5, 0, time.UTC) }
+	tbl := newTestTable([]string{"size", "took", "seen"}, [][]string{
+		{"1536", "3723", "2020-03-27T15:01:05Z"},
+		{"n/a", "90s", "2020-03-25"},
+	})
+	tbl.SetColumnHumanizer(0, HumanizeBytes)
+	tbl.SetColumnHumanizer(1, HumanizeDuration)
+	tbl.SetColumnHumanizer(2, HumanizeRelativeTime)
+	want := "" +
+		"+--------+--------+---------------+\n" +
+		"|  size  |  took  |     seen      |\n" +
+		"|--------|--------|---------------|\n" +
+		"| 1.5KiB | 1h2m3s | 3 minutes ago |\n" +
+		"|  n/a   | 1m30s  |  2 days ago   |\n" +
+		"+--------+--------+---------------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	tbl.SetColumnHumanizer(0, HumanizeNone)
+	if tbl.column(0).format != nil {
+		t.Errorf("Table.SetColumnHumanizer(HumanizeNone) did not remove the formatter")
+	}
+}
+
+func TestFormatRelativeTime(t *testing.T) {
+	since := time.Date(2020, 3, 27, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "just now"},
+		{-time.Second, "1 second ago"},
+		{-3*time.Minute - 59*time.Second, "3 minutes ago"},
+		{-time.Hour, "1 hour ago"},
+		{2 * 24 * time.Hour, "in 2 days"},
+		{-60 * 24 * time.Hour, "2 months ago"},
+		{800 * 24 * time.Hour, "in 2 years"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatRelativeTime(since.Add(tt.d), since); got != tt.want {
+				t.Errorf("FormatRelativeTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0B"},
+		{1023, "1023B"},
+		{1024, "1KiB"},
+		{1288490189, "1.2GiB"},
+		{-2048, "-2KiB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatBytes(tt.n); got != tt.want {
+				t.Errorf("FormatBytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := FormatDuration(3723500 * time.Millisecond); got != "1h2m4s" {
+		t.Errorf("FormatDuration() = %v, want 1h2m4s", got)
+	}
+}
@@ -0,0 +1,161 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A ColumnKind bundles the alignment, formatting, and overflow handling for a common type of column.
+type ColumnKind int
+
+const (
+	// KindDefault uses the table-wide settings.
+	KindDefault ColumnKind = iota
+	// KindID is left-aligned and never wrapped or truncated.
+	KindID
+	// KindName is left-aligned and wraps.
+	KindName
+	// KindCount is right-aligned, never wrapped or truncated, and formats integers with thousands separators (e.g., "1,234").
+	KindCount
+	// KindPercent is right-aligned, never wrapped or truncated, and formats fractions as percentages (e.g., "0.123" as "12.3%").
+	KindPercent
+	// KindTimestamp is left-aligned, never wrapped or truncated, and formats RFC 3339 timestamps as "2006-01-02 15:04:05".
+	KindTimestamp
+	// KindNumber is right-aligned, never wrapped or truncated, and formats numbers with thousands separators,
+	// keeping their decimal places (e.g., "1234567.89" as "1,234,567.89").
+	KindNumber
+)
+
+// SetColumnKind configures column `col` (starting at 0) with the settings bundled by `kind`.
+// Formatting applies only to non-header cells, and cells that cannot be parsed are left unchanged.
+// KindDefault restores the table-wide alignment, overflow, and formatting, and keeps the column's other settings.
+func (tbl *Table) SetColumnKind(col int, kind ColumnKind) {
+	tbl.updateColumn(col, func(c *columnSettings) {
+		c.kind = kind
+		switch kind {
+		case KindID:
+			c.setAlignment(AlignLeft)
+			c.overflow = OverflowNone
+			c.format = nil
+		case KindName:
+			c.setAlignment(AlignLeft)
+			c.overflow = OverflowWrap
+			c.format = nil
+		case KindCount:
+			c.setAlignment(AlignRight)
+			c.overflow = OverflowNone
+			c.format = formatCount
+		case KindPercent:
+			c.setAlignment(AlignRight)
+			c.overflow = OverflowNone
+			c.format = formatPercent
+		case KindTimestamp:
+			c.setAlignment(AlignLeft)
+			c.overflow = OverflowNone
+			c.format = formatTimestamp
+		case KindNumber:
+			c.setAlignment(AlignRight)
+			c.overflow = OverflowNone
+			c.format = formatNumber
+		default:
+			// settings made through other APIs (e.g., padding or minimum width) are kept
+			c.hasAlignment = false
+			c.overflow = OverflowDefault
+			c.format = nil
+		}
+	})
+}
+
+// SetColumnKinds configures each column with the corresponding kind, starting with the first column.
+func (tbl *Table) SetColumnKinds(kinds ...ColumnKind) {
+	for k := range kinds {
+		tbl.SetColumnKind(k, kinds[k])
+	}
+}
+
+// formatCount formats an integer with thousands separators.
+func formatCount(s string) string {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return s
+	}
+	return groupThousands(strconv.FormatInt(n, 10))
+}
+
+// formatNumber formats a number with thousands separators, keeping its decimal places.
+// Only plain decimal numbers are formatted, and other forms (e.g., "1e3", "0x1p4", or "Inf") are returned unchanged.
+func formatNumber(s string) string {
+	s = strings.TrimSpace(s)
+	if !isPlainDecimal(s) {
+		return s
+	}
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		integer, fraction = s[:i], s[i:]
+	}
+	return groupThousands(integer) + fraction
+}
+
+// isPlainDecimal returns true if `s` is an optionally negative integer, optionally followed by a decimal point and more digits (e.g., "-1234.5").
+func isPlainDecimal(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	integer, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		integer, fraction = s[:i], s[i+1:]
+		if fraction == "" {
+			return false
+		}
+	}
+	return integer != "" && isDigits(integer) && isDigits(fraction)
+}
+
+// isDigits returns true if every byte in `s` is an ASCII digit.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// groupThousands inserts a comma between every group of 3 digits in the integer `s` (e.g., "-1234" -> "-1,234").
+func groupThousands(s string) string {
+	return groupDigits(s, ",")
+}
+
+// groupDigits inserts `sep` between every group of 3 digits in the integer `s`.
+func groupDigits(s string, sep string) string {
+	var sign string
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	var ret strings.Builder
+	ret.WriteString(sign)
+	for i := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			ret.WriteString(sep)
+		}
+		ret.WriteByte(s[i])
+	}
+	return ret.String()
+}
+
+// formatPercent formats a fraction as a percentage with 1 decimal place.
+func formatPercent(s string) string {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return s
+	}
+	return strconv.FormatFloat(f*100, 'f', 1, 64) + "%"
+}
+
+// formatTimestamp formats an RFC 3339 timestamp as "2006-01-02 15:04:05".
+func formatTimestamp(s string) string {
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(s))
+	if err != nil {
+		return s
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
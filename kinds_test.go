@@ -0,0 +1,135 @@
+package tablewriter
+
+import "testing"
+
+func Test_formatCount(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"0", "0"},
+		{"123", "123"},
+		{"1234", "1,234"},
+		{"1234567", "1,234,567"},
+		{"-1234", "-1,234"},
+		{"foo", "foo"},
+		{"1.5", "1.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := formatCount(tt.s); got != tt.want {
+				t.Errorf("formatCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_formatPercent(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"0.123", "12.3%"},
+		{"1", "100.0%"},
+		{"foo", "foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := formatPercent(tt.s); got != tt.want {
+				t.Errorf("formatPercent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_formatTimestamp(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"2020-03-27T15:04:05Z", "2020-03-27 15:04:05"},
+		{"2020-03-27", "2020-03-27"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := formatTimestamp(tt.s); got != tt.want {
+				t.Errorf("formatTimestamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetColumnKinds(t *testing.T) {
+	tbl := newTestTable([]string{"id", "name", "count", "share", "at"}, [][]string{
+		{"a1", "foo", "1234", "0.5", "2020-03-27T15:04:05Z"},
+		{"b22", "bar", "5", "0.25", "n/a"},
+	})
+	tbl.SetColumnKinds(KindID, KindName, KindCount, KindPercent, KindTimestamp)
+	want := "" +
+		"+-----+------+-------+-------+---------------------+\n" +
+		"| id  | name | count | share |         at          |\n" +
+		"|-----|------|-------|-------|---------------------|\n" +
+		"| a1  | foo  | 1,234 | 50.0% | 2020-03-27 15:04:05 |\n" +
+		"| b22 | bar  |     5 | 25.0% | n/a                 |\n" +
+		"+-----+------+-------+-------+---------------------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() = %v, want %v", got, want)
+	}
+
+	// resetting a column to the default kind removes its settings
+	tbl.SetColumnKind(2, KindDefault)
+	if c := tbl.column(2); c.hasAlignment || c.format != nil || c.overflow != OverflowDefault {
+		t.Errorf("Table.SetColumnKind(KindDefault) -> %v, want default settings", c)
+	}
+}
+
+func Test_formatNumber(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"1234567.89", "1,234,567.89"},
+		{"-1234.5", "-1,234.5"},
+		{"12", "12"},
+		{" 1234 ", "1,234"},
+		{"foo", "foo"},
+		{"12345e3", "12345e3"},
+		{"-1.5E-10", "-1.5E-10"},
+		{"0x1p-2", "0x1p-2"},
+		{"0x1234", "0x1234"},
+		{"Inf", "Inf"},
+		{"-Inf", "-Inf"},
+		{"+Inf", "+Inf"},
+		{"NaN", "NaN"},
+		{"1_234", "1_234"},
+		{"1234.", "1234."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if got := formatNumber(tt.s); got != tt.want {
+				t.Errorf("formatNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetColumnKind_defaultKeepsOtherSettings(t *testing.T) {
+	tbl := newTestTable([]string{"n"}, [][]string{{"1234"}})
+	tbl.SetColumnPadding(0, 2, 3)
+	tbl.SetMinColumnWidth(0, 8)
+	tbl.SetColumnPriority(0, ShrinkLast)
+	tbl.SetColumnPercentOfTotal(0, 1)
+	tbl.SetColumnKind(0, KindCount)
+	tbl.SetColumnKind(0, KindDefault)
+	c := tbl.column(0)
+	if c.kind != KindDefault || c.hasAlignment || c.overflow != OverflowDefault || c.format != nil {
+		t.Errorf("Table.SetColumnKind(KindDefault) kept the settings of KindCount: %+v", c)
+	}
+	if c.padding == nil || *c.padding != (cellPadding{left: 2, right: 3}) || c.minWidth != 8 || c.priority != ShrinkLast || c.percent == nil {
+		t.Errorf("Table.SetColumnKind(KindDefault) reset settings made through other methods: %+v", c)
+	}
+}
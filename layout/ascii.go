@@ -0,0 +1,47 @@
+package layout
+
+import "strings"
+
+// The functions in this file are fast paths for text that is entirely ASCII, in which every byte is one rune of width 1,
+// so that text can be measured, truncated, and wrapped by slicing it directly, without decoding it into runes.
+
+// asciiSpace is the set of ASCII characters for which unicode.IsSpace is true.
+const asciiSpace = " \t\n\v\f\r"
+
+// IsASCII returns true if `s` contains only ASCII bytes.
+func IsASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// isASCIISpace returns true if the ASCII byte `c` is whitespace.
+func isASCIISpace(c byte) bool {
+	return strings.IndexByte(asciiSpace, c) >= 0
+}
+
+// TruncateASCII is like TruncateRunes, but for ASCII text. Expects len(s) to exceed width.
+func TruncateASCII(s string, width int) string {
+	keep, suffix := truncation(width)
+	return s[:keep] + suffix
+}
+
+// WrapASCII is like WrapRunes, but for ASCII text. Expects len(s) to exceed width.
+func WrapASCII(s string, width int) (line string, remainder string) {
+	if width < 2 {
+		return s[:1], s[1:]
+	}
+	if isASCIISpace(s[width-1]) {
+		return s[:width-1], s[width:]
+	}
+	if isASCIISpace(s[width-2]) {
+		if isASCIISpace(s[width]) {
+			return s[:width], strings.TrimLeft(s[width:], asciiSpace)
+		}
+		return s[:width-2], s[width-1:]
+	}
+	return s[:width-1] + "-", s[width-1:]
+}
@@ -0,0 +1,81 @@
+package layout
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// StripControls returns `s` with each tab replaced by a space and every other control character except line breaks removed,
+// so that raw text (e.g., log data) cannot move the cursor and break the alignment of a table.
+// If `escape` is true, the other control characters are instead replaced by a visible escape (e.g., "\x0d" for a carriage return).
+// ANSI control sequences (ESC "[" parameters final-byte) are retained. Text without any control characters is returned unchanged.
+func StripControls(s string, escape bool) string {
+	if !hasControls(s) {
+		return s
+	}
+	ret := strings.Builder{}
+	ret.Grow(len(s))
+	for i := 0; i < len(s); {
+		if end := escapeEnd(s, i); end > i {
+			ret.WriteString(s[i:end])
+			i = end
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		switch {
+		case r == '\n' || !unicode.IsControl(r):
+			ret.WriteRune(r)
+		case r == '\t':
+			ret.WriteByte(' ')
+		case escape:
+			fmt.Fprintf(&ret, `\x%02x`, r)
+		}
+	}
+	return ret.String()
+}
+
+// hasControls returns true if `s` contains any control characters other than line breaks.
+func hasControls(s string) bool {
+	for _, r := range s {
+		if r != '\n' && unicode.IsControl(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandTabs returns `s` with each tab replaced by enough spaces to reach the next multiple of `tabWidth` within its line,
+// measured in runes and excluding ANSI control sequences. Widths less than 1 return `s` unchanged.
+func ExpandTabs(s string, tabWidth int) string {
+	if tabWidth < 1 || strings.IndexByte(s, '\t') < 0 {
+		return s
+	}
+	ret := strings.Builder{}
+	ret.Grow(len(s) + tabWidth)
+	var col int
+	for i := 0; i < len(s); {
+		if end := escapeEnd(s, i); end > i {
+			ret.WriteString(s[i:end])
+			i = end
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		switch r {
+		case '\t':
+			n := tabWidth - col%tabWidth
+			ret.WriteString(strings.Repeat(" ", n))
+			col += n
+		case '\n':
+			ret.WriteRune(r)
+			col = 0
+		default:
+			ret.WriteRune(r)
+			col++
+		}
+	}
+	return ret.String()
+}
@@ -0,0 +1,62 @@
+package layout
+
+import "unicode"
+
+// zeroWidthJoiner joins the emoji on either side of it into a single grapheme cluster (e.g., "👨‍👩‍👧").
+const zeroWidthJoiner = '\u200d'
+
+// isRegionalIndicator returns true if `r` is one of the symbols that form flag emoji in pairs (e.g., "🇫🇷").
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// extendsCluster returns true if `r` belongs to the same grapheme cluster as the rune `prev` before it,
+// which is the last of `regional` consecutive regional indicators (0 if `prev` is not a regional indicator).
+// This approximates the extended grapheme cluster rules of Unicode Standard Annex #29 for combining marks,
+// variation selectors, emoji modifiers, zero-width joiner sequences, emoji tags, and flags.
+func extendsCluster(prev, r rune, regional int) bool {
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		return true
+	case r == zeroWidthJoiner || prev == zeroWidthJoiner:
+		return true
+	case r >= 0xfe00 && r <= 0xfe0f, r >= 0xe0100 && r <= 0xe01ef:
+		// variation selectors
+		return true
+	case r >= 0x1f3fb && r <= 0x1f3ff:
+		// emoji skin tone modifiers
+		return true
+	case r >= 0xe0020 && r <= 0xe007f:
+		// emoji tag sequences (e.g., subdivision flags)
+		return true
+	case isRegionalIndicator(r):
+		return regional%2 == 1
+	}
+	return false
+}
+
+// clusterBoundary returns the largest position no greater than `n` in `r` that does not split a grapheme cluster,
+// or `n` itself if the first cluster is longer than `n` runes, so that callers always make progress.
+// Expects 0 <= n <= len(r).
+func clusterBoundary(r []rune, n int) int {
+	if n == 0 || n >= len(r) {
+		return n
+	}
+	// last is the start of the cluster containing r[i]
+	var regional int
+	last := 0
+	for i := 1; i <= n; i++ {
+		if isRegionalIndicator(r[i-1]) {
+			regional++
+		} else {
+			regional = 0
+		}
+		if !extendsCluster(r[i-1], r[i], regional) {
+			last = i
+		}
+	}
+	if last == 0 {
+		return n
+	}
+	return last
+}
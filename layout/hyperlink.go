@@ -0,0 +1,30 @@
+package layout
+
+import "strings"
+
+// hyperlinkPrefix begins every OSC 8 hyperlink sequence.
+const hyperlinkPrefix = "\x1b]8;"
+
+// HyperlinkEnd is the OSC 8 sequence that ends a hyperlink.
+const HyperlinkEnd = hyperlinkPrefix + ";\x1b\\"
+
+// Hyperlink returns `s` as an OSC 8 terminal hyperlink to `url`. The escape sequences are zero-width (see Width),
+// and terminals that do not support hyperlinks display only `s`. An empty `url` returns `s` unchanged.
+func Hyperlink(s, url string) string {
+	if url == "" {
+		return s
+	}
+	return hyperlinkPrefix + ";" + url + "\x1b\\" + s + HyperlinkEnd
+}
+
+// isHyperlinkEnd returns true if the OSC 8 sequence `seq` ends a hyperlink (i.e., has an empty URI).
+func isHyperlinkEnd(seq string) bool {
+	// the sequence is ESC "]8;" params ";" URI terminator
+	rest := strings.TrimPrefix(seq, hyperlinkPrefix)
+	semi := strings.IndexByte(rest, ';')
+	if semi < 0 {
+		return true
+	}
+	uri := strings.TrimSuffix(strings.TrimSuffix(rest[semi+1:], "\x1b\\"), "\a")
+	return uri == ""
+}
@@ -0,0 +1,61 @@
+// Package layout provides the low-level text layout primitives used by tablewriter
+// (width measurement, sanitizing, alignment, wrapping, truncation, and dividing lines),
+// so that other command-line tooling (e.g., prompts or two-column help output) can reuse them without constructing a Table.
+//
+// Widths are measured in runes, excluding ANSI control sequences.
+package layout
+
+import "strings"
+
+// An Alignment configures how text is aligned within a fixed width.
+// Its values match tablewriter.Alignment.
+type Alignment int
+
+const (
+	// AlignCenter centers the text. Any odd space is placed on the right.
+	AlignCenter Alignment = iota
+	// AlignRight right-justifies the text.
+	AlignRight
+	// AlignLeft left-justifies the text.
+	AlignLeft
+)
+
+// Padding returns the number of spaces to place on the left and right of text that is `textWidth` wide
+// to align it within `width` according to `alignment`. Text wider than `width` is not padded.
+func Padding(textWidth, width int, alignment Alignment) (left, right int) {
+	total := width - textWidth
+	if total < 0 {
+		return 0, 0
+	}
+	switch alignment {
+	case AlignLeft:
+		return 0, total
+	case AlignRight:
+		return total, 0
+	default:
+		return total / 2, total - total/2
+	}
+}
+
+// Align sanitizes `s` and pads it with spaces to `width` according to `alignment`.
+// Text wider than `width` is returned without padding.
+func Align(s string, width int, alignment Alignment) string {
+	s = Sanitize(s)
+	left, right := Padding(Width(s), width, alignment)
+	return strings.Repeat(" ", left) + s + strings.Repeat(" ", right)
+}
+
+// Divider returns a dividing line with `filler` repeated to the full width of each column in `widths`,
+// separated and enclosed by `edge`, and terminated by a newline. For example, ([]int{3, 1}, "+", "-") -> "+---+-+\n".
+func Divider(widths []int, edge, filler string) string {
+	ret := strings.Builder{}
+	ret.WriteString(edge)
+	for _, width := range widths {
+		if width > 0 {
+			ret.WriteString(strings.Repeat(filler, width))
+		}
+		ret.WriteString(edge)
+	}
+	ret.WriteByte('\n')
+	return ret.String()
+}
@@ -0,0 +1,356 @@
+package layout
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ASCII", "foo", 3},
+		{"non-ASCII", "å¬ßø", 4},
+		{"SGR", "\x1b[1;31mfoo\x1b[0m", 3},
+		{"other control sequence", "\x1b[3Afoo\x1b[K", 3},
+		{"unterminated sequence", "foo\x1b[1", 3},
+		{"hyperlink", "\x1b]8;;http://x\x1b\\foo\x1b]8;;\x1b\\", 3},
+		{"hyperlink with BEL", "\x1b]8;;http://x\afoo\x1b]8;;\a", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Width(tt.s); got != tt.want {
+				t.Errorf("Width() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"unchanged", "héllo, 世界", "héllo, 世界"},
+		{"invalid utf-8", "a\xffb", "a�b"},
+		{"lone surrogate", "a\xed\xa0\x80b", "a���b"},
+		{"rtl override", "abc\u202edef\u202c", "abcdef"},
+		{"isolate", "\u2067abc\u2069", "abc"},
+		{"combining marks within limit", "e\u0301\u0302", "e\u0301\u0302"},
+		{"combining run", "z" + strings.Repeat("\u0301", 50) + "a", "z" + strings.Repeat("\u0301", MaxCombiningMarks) + "a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sanitize(tt.s); got != tt.want {
+				t.Errorf("Sanitize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripControls(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		escape bool
+		want   string
+	}{
+		{"unchanged", "foo\nbar", false, "foo\nbar"},
+		{"tab", "foo\tbar", false, "foo bar"},
+		{"carriage return", "foo\r\n", false, "foo\n"},
+		{"escaped", "a\rb\x00c\u0085", true, `a\x0db\x00c\x85`},
+		{"escaped tab", "a\tb", true, "a b"},
+		{"ANSI sequence", "\x1b[31mfoo\x1b[0m\x07", false, "\x1b[31mfoo\x1b[0m"},
+		{"lone escape", "\x1bfoo", true, `\x1bfoo`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripControls(tt.s, tt.escape); got != tt.want {
+				t.Errorf("StripControls() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandTabs(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		tabWidth int
+		want     string
+	}{
+		{"no tabs", "foo", 4, "foo"},
+		{"disabled", "a\tb", 0, "a\tb"},
+		{"leading tab", "\tb", 4, "    b"},
+		{"next tab stop", "ab\tc\td", 4, "ab  c   d"},
+		{"tab at stop", "abcd\te", 4, "abcd    e"},
+		{"multi-line", "a\tb\nabc\td", 4, "a   b\nabc d"},
+		{"ANSI sequence", "\x1b[1ma\x1b[0m\tb", 4, "\x1b[1ma\x1b[0m   b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandTabs(tt.s, tt.tabWidth); got != tt.want {
+				t.Errorf("ExpandTabs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestASCII_matchesRunes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	const alphabet = "ab c\t"
+	for n := 0; n < 2000; n++ {
+		b := make([]byte, 2+rng.Intn(12))
+		for i := range b {
+			b[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		s := string(b)
+		width := rng.Intn(len(s))
+		if got, want := TruncateASCII(s, width), TruncateRunes([]rune(s), width); got != want {
+			t.Fatalf("TruncateASCII(%q, %d) = %q, want %q", s, width, got, want)
+		}
+		if width == 0 {
+			continue
+		}
+		line, remainder := WrapASCII(s, width)
+		wantLine, wantRemainder := WrapRunes([]rune(s), width)
+		if line != string(wantLine) || remainder != wantRemainder {
+			t.Fatalf("WrapASCII(%q, %d) = %q, %q, want %q, %q", s, width, line, remainder, string(wantLine), wantRemainder)
+		}
+	}
+}
+
+func TestIsASCII(t *testing.T) {
+	if !IsASCII("foo bar\x1b[0m") {
+		t.Errorf("IsASCII(ASCII) = false, want true")
+	}
+	if IsASCII("fóo") {
+		t.Errorf("IsASCII(non-ASCII) = true, want false")
+	}
+}
+
+func TestPadding(t *testing.T) {
+	tests := []struct {
+		name      string
+		textWidth int
+		width     int
+		alignment Alignment
+		wantLeft  int
+		wantRight int
+	}{
+		{"left", 2, 5, AlignLeft, 0, 3},
+		{"right", 2, 5, AlignRight, 3, 0},
+		{"center - odd space on the right", 2, 5, AlignCenter, 1, 2},
+		{"too wide", 6, 5, AlignCenter, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right := Padding(tt.textWidth, tt.width, tt.alignment)
+			if left != tt.wantLeft || right != tt.wantRight {
+				t.Errorf("Padding() = %v, %v, want %v, %v", left, right, tt.wantLeft, tt.wantRight)
+			}
+		})
+	}
+}
+
+func TestDivider(t *testing.T) {
+	tests := []struct {
+		name   string
+		widths []int
+		edge   string
+		filler string
+		want   string
+	}{
+		{"border", []int{3, 1}, "+", "-", "+---+-+\n"},
+		{"no columns", nil, "|", "=", "|\n"},
+		{"zero width", []int{0, 2}, "+", "-", "++--+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Divider(tt.widths, tt.edge, tt.filler); got != tt.want {
+				t.Errorf("Divider() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// adversarial inputs must never panic, and must always produce valid UTF-8 within the requested width.
+func TestTextPipeline_adversarial(t *testing.T) {
+	alphabet := []string{"a", " ", "\xff", "\xed\xa0\x80", "\u0301", "\u202e", "\u2066", "世", "\u200d", "-", "\t"}
+	rng := rand.New(rand.NewSource(1))
+	for n := 0; n < 2000; n++ {
+		var b strings.Builder
+		for i := rng.Intn(40); i > 0; i-- {
+			b.WriteString(alphabet[rng.Intn(len(alphabet))])
+		}
+		s := b.String()
+		width := rng.Intn(8) - 1
+		for _, line := range Wrap(s, width) {
+			if !utf8.ValidString(line) || (width > 0 && Width(line) > width) {
+				t.Fatalf("Wrap(%q, %d) produced line %q", s, width, line)
+			}
+		}
+		if got := Truncate(s, width); !utf8.ValidString(got) || Width(got) > width && width > 0 {
+			t.Fatalf("Truncate(%q, %d) = %q", s, width, got)
+		}
+		if got := Align(s, width, AlignCenter); !utf8.ValidString(got) {
+			t.Fatalf("Align(%q, %d) = %q", s, width, got)
+		}
+	}
+}
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"plain", "foo", "foo"},
+		{"colored", "\x1b[1;31mfoo\x1b[0m", "foo"},
+		{"hyperlink", Hyperlink("foo", "http://x"), "foo"},
+		{"unicode", "\x1b[4mé\x1b[0m!", "é!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripANSI(tt.s); got != tt.want {
+				t.Errorf("StripANSI() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateANSI(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{"fits", "\x1b[31mfoo\x1b[0m", 3, "\x1b[31mfoo\x1b[0m"},
+		{"plain", "foobar", 5, "fo..."},
+		{"colored", "\x1b[31mfoobar\x1b[0m", 5, "\x1b[31mfo...\x1b[0m"},
+		{"sequence at cut", "fo\x1b[1mobar", 5, "fo\x1b[1m...\x1b[0m"},
+		{"narrow", "\x1b[31mfoobar", 2, "\x1b[31mf…\x1b[0m"},
+		{"width 1", "\x1b[31mfoobar", 1, "\x1b[31m…\x1b[0m"},
+		{"zero width", "foobar", 0, ""},
+		{"hyperlink", Hyperlink("foobar", "http://x"), 5, "\x1b]8;;http://x\x1b\\fo...\x1b[0m" + HyperlinkEnd},
+		{"closed hyperlink", Hyperlink("fo", "http://x") + "obar", 5, Hyperlink("fo", "http://x") + "...\x1b[0m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateANSI(tt.s, tt.width)
+			if got != tt.want {
+				t.Errorf("TruncateANSI() = %q, want %q", got, tt.want)
+			}
+			if tt.width > 0 && Width(got) > tt.width {
+				t.Errorf("Width(TruncateANSI()) = %v, want at most %v", Width(got), tt.width)
+			}
+		})
+	}
+}
+
+func TestWrapANSI(t *testing.T) {
+	tests := []struct {
+		name          string
+		s             string
+		width         int
+		wantLine      string
+		wantRemainder string
+	}{
+		{"fits", "\x1b[1mfoo\x1b[0m", 3, "\x1b[1mfoo\x1b[0m", ""},
+		{"plain", "foo bar", 4, "foo", "bar"},
+		{"closed before break", "\x1b[1mfoo\x1b[0m bar", 4, "\x1b[1mfoo\x1b[0m", "bar"},
+		{"open across break", "\x1b[1mfoo bar\x1b[0m", 4, "\x1b[1mfoo\x1b[0m", "\x1b[1mbar\x1b[0m"},
+		{"hyphenated", "\x1b[1mfoobar\x1b[0m", 4, "\x1b[1mfoo-\x1b[0m", "\x1b[1mbar\x1b[0m"},
+		{"sequence in dropped space", "foo \x1b[1mbar\x1b[0m", 4, "foo", "\x1b[1mbar\x1b[0m"},
+		{"non-ASCII", "caf\u00e9 \x1b[1mn\u00e9\x1b[0m", 5, "caf\u00e9", "\x1b[1mn\u00e9\x1b[0m"},
+		{"hyperlink", Hyperlink("foo bar", "http://x"), 4, "\x1b]8;;http://x\x1b\\foo" + HyperlinkEnd, "\x1b]8;;http://x\x1b\\bar" + HyperlinkEnd},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, remainder := WrapANSI(tt.s, tt.width)
+			if line != tt.wantLine || remainder != tt.wantRemainder {
+				t.Errorf("WrapANSI() = %q, %q, want %q, %q", line, remainder, tt.wantLine, tt.wantRemainder)
+			}
+			if Width(line) > tt.width {
+				t.Errorf("Width(WrapANSI()) = %v, want at most %v", Width(line), tt.width)
+			}
+		})
+	}
+}
+
+func Test_clusterBoundary(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		n    int
+		want int
+	}{
+		{"ascii", "abc", 2, 2},
+		{"combining mark", "ae\u0301b", 2, 1},
+		{"after combining mark", "ae\u0301b", 3, 3},
+		{"zwj sequence", "a\U0001f468\u200d\U0001f469\u200d\U0001f467", 3, 1},
+		{"skin tone", "a\U0001f44d\U0001f3fd", 2, 1},
+		{"flags", "\U0001f1eb\U0001f1f7\U0001f1e9\U0001f1ea", 3, 2},
+		{"first cluster too long", "e\u0301\u0302", 2, 2},
+		{"zero", "abc", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clusterBoundary([]rune(tt.s), tt.n); got != tt.want {
+				t.Errorf("clusterBoundary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrap_graphemeClusters(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  []string
+	}{
+		{"combining mark", "cafe\u0301s", 5, []string{"caf-", "e\u0301s"}},
+		{"zwj sequence", "ab\U0001f468\u200d\U0001f469\u200d\U0001f467", 5, []string{"ab-", "\U0001f468\u200d\U0001f469\u200d\U0001f467"}},
+		{"skin tone", "abc\U0001f44d\U0001f3fdd", 5, []string{"abc-", "\U0001f44d\U0001f3fdd"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.s, tt.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Wrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate_graphemeClusters(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{"combining mark", "e\u0301e\u0301e\u0301e\u0301", 5, "e\u0301..."},
+		{"zwj sequence", "a\U0001f468\u200d\U0001f469\u200d\U0001f467b", 6, "a..."},
+		{"skin tone", "ab\U0001f44d\U0001f3fdcde", 6, "ab..."},
+		{"flags", "\U0001f1eb\U0001f1f7\U0001f1e9\U0001f1ea\U0001f1ee\U0001f1f9", 3, "\U0001f1eb\U0001f1f7…"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Truncate(tt.s, tt.width); got != tt.want {
+				t.Errorf("Truncate() = %q, want %q", got, tt.want)
+			}
+			if got := TruncateANSI("\x1b[1m"+tt.s, tt.width); got != "\x1b[1m"+tt.want+"\x1b[0m" {
+				t.Errorf("TruncateANSI() = %q, want %q", got, "\x1b[1m"+tt.want+"\x1b[0m")
+			}
+		})
+	}
+}
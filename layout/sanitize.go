@@ -0,0 +1,75 @@
+package layout
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxCombiningMarks is the maximum number of consecutive combining marks retained by Sanitize after each base character.
+// Longer runs (e.g., "zalgo" text) are shortened so that they cannot render as arbitrarily tall glyphs.
+const MaxCombiningMarks = 4
+
+// Sanitize returns `s` with its defined sanitizing behavior for untrusted text applied:
+// invalid UTF-8 (including encoded lone surrogates) is replaced with U+FFFD,
+// bidirectional formatting characters (e.g., RTL overrides, which can visually reorder the text and borders that follow them) are removed,
+// and runs of combining marks longer than MaxCombiningMarks are shortened.
+// Valid text without any of these is returned unchanged.
+func Sanitize(s string) string {
+	if !needsSanitizing(s) {
+		return s
+	}
+	ret := strings.Builder{}
+	ret.Grow(len(s))
+	var marks int
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		switch {
+		case r == utf8.RuneError && size <= 1:
+			// invalid byte
+			marks = 0
+			ret.WriteRune(utf8.RuneError)
+		case isBidiControl(r):
+		case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r):
+			marks++
+			if marks <= MaxCombiningMarks {
+				ret.WriteRune(r)
+			}
+		default:
+			marks = 0
+			ret.WriteRune(r)
+		}
+	}
+	return ret.String()
+}
+
+// needsSanitizing returns true if Sanitize would change `s`.
+func needsSanitizing(s string) bool {
+	var marks int
+	for i, r := range s {
+		switch {
+		case r == utf8.RuneError:
+			if _, size := utf8.DecodeRuneInString(s[i:]); size <= 1 {
+				return true
+			}
+			marks = 0
+		case isBidiControl(r):
+			return true
+		case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r):
+			marks++
+			if marks > MaxCombiningMarks {
+				return true
+			}
+		default:
+			marks = 0
+		}
+	}
+	return false
+}
+
+// isBidiControl returns true for the explicit bidirectional formatting characters (embeddings, overrides, and isolates)
+// and the implicit directional marks.
+func isBidiControl(r rune) bool {
+	return unicode.Is(unicode.Bidi_Control, r)
+}
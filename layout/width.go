@@ -0,0 +1,75 @@
+package layout
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Width returns the rune width of `s`, excluding any ANSI control sequences (ESC "[" parameters final-byte)
+// and operating system commands such as OSC 8 hyperlinks (ESC "]" text, terminated by BEL or ESC "\").
+func Width(s string) int {
+	if strings.IndexByte(s, '\x1b') < 0 {
+		return utf8.RuneCountInString(s)
+	}
+	var width int
+	for i := 0; i < len(s); {
+		if end := escapeEnd(s, i); end > i {
+			i = end
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		width++
+	}
+	return width
+}
+
+// escapeEnd returns the position just past the ANSI control sequence or operating system command that starts at s[i],
+// or i if neither starts there. An unterminated sequence or command extends to the end of `s`.
+func escapeEnd(s string, i int) int {
+	if s[i] != '\x1b' || i+1 >= len(s) {
+		return i
+	}
+	switch s[i+1] {
+	case '[':
+		// skip parameter and intermediate bytes up to and including the final byte (0x40-0x7E)
+		i += 2
+		for i < len(s) && (s[i] < 0x40 || s[i] > 0x7e) {
+			i++
+		}
+		if i < len(s) {
+			i++
+		}
+		return i
+	case ']':
+		// skip the command text up to and including the terminator (BEL or ESC "\")
+		for i += 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return i
+	default:
+		return i
+	}
+}
+
+// StripANSI returns `s` without any ANSI control sequences or operating system commands (see Width).
+func StripANSI(s string) string {
+	if strings.IndexByte(s, '\x1b') < 0 {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if end := escapeEnd(s, i); end > i {
+			i = end
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
@@ -0,0 +1,254 @@
+package layout
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Truncate sanitizes `s` and shortens it to at most `width` runes, replacing its end with "...",
+// or with "…" at widths less than 4 (e.g., "…" at width 1). Widths less than 1 return an empty string.
+// Grapheme clusters (e.g., "é" written with a combining accent, or "👨‍👩‍👧") are never split, unless the first cluster alone is too wide.
+func Truncate(s string, width int) string {
+	s = Sanitize(s)
+	if width < 1 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return TruncateRunes([]rune(s), width)
+}
+
+// Ellipses that replace the end of truncated text, depending on the width available.
+const (
+	ellipsis       = "..."
+	narrowEllipsis = "…"
+)
+
+// TruncateRunes shortens `r` to `width` runes, replacing its end with "...", or with "…" at widths less than 4.
+// Widths less than 1 return an empty string. Expects len(r) to exceed width.
+func TruncateRunes(r []rune, width int) string {
+	keep, suffix := truncation(width)
+	return string(r[:clusterBoundary(r, keep)]) + suffix
+}
+
+// truncation returns the number of runes kept when text is truncated to `width`, and the ellipsis that follows them.
+func truncation(width int) (keep int, suffix string) {
+	switch {
+	case width < 1:
+		return 0, ""
+	case width < 4:
+		return width - 1, narrowEllipsis
+	default:
+		return width - 3, ellipsis
+	}
+}
+
+// TruncateANSI is like Truncate, but treats ANSI control sequences in `s` as zero-width:
+// sequences are never cut in the middle, and if the shortened text contains any,
+// a reset sequence ("\x1b[0m") is appended so that styling does not bleed past the end of the text.
+// Likewise, an OSC 8 hyperlink left open by the shortened text is closed. `s` is not sanitized.
+func TruncateANSI(s string, width int) string {
+	if width < 1 {
+		return ""
+	}
+	if Width(s) <= width {
+		return s
+	}
+	keep, suffix := truncation(width)
+	ret := make([]byte, 0, len(s)+len(suffix))
+	var n int
+	var styled, linked bool
+	// the current grapheme cluster starts at ret[start], after its first `kept` runes
+	var start, kept, regional int
+	var prev rune
+	for i := 0; i < len(s); {
+		if end := escapeEnd(s, i); end > i {
+			ret = append(ret, s[i:end]...)
+			styled = true
+			if strings.HasPrefix(s[i:end], hyperlinkPrefix) {
+				linked = !isHyperlinkEnd(s[i:end])
+			}
+			i = end
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		extends := n > 0 && extendsCluster(prev, r, regional)
+		if n == keep {
+			// drop a grapheme cluster that would be split, unless it is the only one
+			if extends && kept > 0 {
+				ret = ret[:start]
+			}
+			break
+		}
+		if !extends {
+			start, kept = len(ret), n
+		}
+		if isRegionalIndicator(r) {
+			regional++
+		} else {
+			regional = 0
+		}
+		ret = append(ret, s[i:i+size]...)
+		prev = r
+		i += size
+		n++
+	}
+	ret = append(ret, suffix...)
+	if styled {
+		ret = append(ret, "\x1b[0m"...)
+	}
+	if linked {
+		ret = append(ret, HyperlinkEnd...)
+	}
+	return string(ret)
+}
+
+// Wrap sanitizes `s` and splits it into lines of at most `width` runes, preferring to break at spaces
+// and inserting a hyphen when a word is split. Widths less than 1 are treated as 1.
+// Grapheme clusters (e.g., "é" written with a combining accent, or "👨‍👩‍👧") are never split, unless a cluster alone is too wide.
+func Wrap(s string, width int) []string {
+	s = Sanitize(s)
+	if width < 1 {
+		width = 1
+	}
+	var ret []string
+	for {
+		line, remainder := WrapLine(s, width)
+		ret = append(ret, line)
+		if remainder == "" {
+			return ret
+		}
+		s = remainder
+	}
+}
+
+// WrapLine splits the first line of at most `width` runes from `s`, as in Wrap, and returns it along with the remainder of `s`.
+// The remainder is empty if `s` fits on a single line.
+func WrapLine(s string, width int) (line string, remainder string) {
+	// no split required?
+	if utf8.RuneCountInString(s) <= width {
+		return s, ""
+	}
+	r, remainder := WrapRunes([]rune(s), width)
+	return string(r), remainder
+}
+
+// WrapRunes is like WrapLine, but returns the first line as runes so that the caller can measure it without decoding it again.
+// Expects len(r) to exceed width.
+func WrapRunes(r []rune, width int) (line []rune, remainder string) {
+	end, next, hyphen := wrapPoint(r, width)
+	if !hyphen {
+		return r[:end], string(r[next:])
+	}
+	ret := make([]rune, end, end+1)
+	copy(ret, r[:end])
+	ret = append(ret, '-')
+	return ret, string(r[next:])
+}
+
+// wrapPoint returns where the first line of `r` wrapped to `width` ends (r[:end], followed by a hyphen if `hyphen` is true),
+// and where the remainder starts (r[next:]), as in WrapRunes. Expects len(r) to exceed width.
+func wrapPoint(r []rune, width int) (end, next int, hyphen bool) {
+	// too narrow for a hyphen? split without one, always keeping at least one rune so that wrapping terminates
+	if width < 2 {
+		return 1, 1, false
+	}
+	// last letter is whitespace? truncate last whitespace
+	if unicode.IsSpace(r[width-1]) {
+		return width - 1, width, false
+	}
+	// penultimate letter is space?
+	if unicode.IsSpace(r[width-2]) {
+		// single-character word? retain on line and truncate the next whitespace
+		if unicode.IsSpace(r[width]) {
+			next := width
+			for next < len(r) && unicode.IsSpace(r[next]) {
+				next++
+			}
+			return width, next, false
+		}
+		// truncate last whitesapce
+		return width - 2, width - 1, false
+	}
+	// multi-character word? insert "-" at end, without splitting a grapheme cluster
+	cut := clusterBoundary(r, width-1)
+	return cut, cut, true
+}
+
+// WrapANSI is like WrapLine, but treats ANSI control sequences in `s` as zero-width: sequences are never cut in the middle,
+// and any styling or OSC 8 hyperlink still open at the end of the first line is closed there and reopened at the start of the remainder,
+// so that it does not bleed past the end of the line. `s` is not sanitized.
+func WrapANSI(s string, width int) (line string, remainder string) {
+	if width < 1 {
+		width = 1
+	}
+	// visible holds the runes outside of control sequences, and pos[i] is the position of visible[i] in `s`
+	var visible []rune
+	var pos []int
+	for i := 0; i < len(s); {
+		if end := escapeEnd(s, i); end > i {
+			i = end
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		visible = append(visible, r)
+		pos = append(pos, i)
+		i += size
+	}
+	if len(visible) <= width {
+		return s, ""
+	}
+	pos = append(pos, len(s))
+	end, next, hyphen := wrapPoint(visible, width)
+	line = s[:pos[end]]
+	// sequences among any whitespace dropped at the break still apply to the remainder
+	var skipped strings.Builder
+	for i := pos[end]; i < pos[next]; {
+		if e := escapeEnd(s, i); e > i {
+			skipped.WriteString(s[i:e])
+			i = e
+			continue
+		}
+		i++
+	}
+	if hyphen {
+		line += "-"
+	}
+	style, link := openSequences(line)
+	if style != "" {
+		line += "\x1b[0m"
+	}
+	if link != "" {
+		line += HyperlinkEnd
+	}
+	return line, style + link + skipped.String() + s[pos[next]:]
+}
+
+// openSequences returns the SGR sequences that still apply at the end of `s` (those after the last reset),
+// and the OSC 8 sequence that opens a hyperlink left open at the end of `s`, if any.
+func openSequences(s string) (style, link string) {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		end := escapeEnd(s, i)
+		if end == i {
+			i++
+			continue
+		}
+		seq := s[i:end]
+		switch {
+		case strings.HasPrefix(seq, hyperlinkPrefix):
+			link = seq
+			if isHyperlinkEnd(seq) {
+				link = ""
+			}
+		case seq == "\x1b[0m" || seq == "\x1b[m":
+			b.Reset()
+		case strings.HasPrefix(seq, "\x1b[") && strings.HasSuffix(seq, "m"):
+			b.WriteString(seq)
+		}
+		i = end
+	}
+	return b.String(), link
+}
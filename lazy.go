@@ -0,0 +1,59 @@
+package tablewriter
+
+import "fmt"
+
+// A CellValuer provides the text of a cell each time the table is rendered, rather than when its row is appended,
+// so that expensive or changing values (e.g., live metrics) are resolved at print time.
+type CellValuer interface {
+	CellValue() string
+}
+
+// A CellFunc is a CellValuer that returns the result of calling the function.
+type CellFunc func() string
+
+// CellValue returns the result of calling `f`.
+func (f CellFunc) CellValue() string {
+	return f()
+}
+
+// A CellText is a CellValuer with fixed text, for mixing fixed and lazy cells in the same row.
+type CellText string
+
+// CellValue returns the text.
+func (s CellText) CellValue() string {
+	return string(s)
+}
+
+// AppendLazyRow appends a non-header row whose cells are resolved by calling each CellValuer once per render,
+// so that re-rendering the table displays fresh values. A nil CellValuer is an empty cell.
+// Until rendered, the row's cells are empty to any methods that read the stored rows (e.g., Row or Rows).
+func (tbl *Table) AppendLazyRow(row []CellValuer) error {
+	cells := make([]string, len(row))
+	err := tbl.sameShape(cells)
+	if err != nil {
+		return fmt.Errorf("appending lazy row: %v", err)
+	}
+	tbl.rows.append(record{cells: cells, values: append([]CellValuer(nil), row...)})
+	tbl.hasValues = true
+	return nil
+}
+
+// withValues returns a copy of `rows` in which the cells of every lazy row are replaced with the current values of its CellValuers.
+func withValues(rows rowStore) rowStore {
+	var ret rowStore
+	for i := 0; i < rows.len(); i++ {
+		r := rows.at(i)
+		if r.values != nil {
+			resolved := r
+			resolved.cells = make([]string, len(r.values))
+			for k, v := range r.values {
+				if v != nil {
+					resolved.cells[k] = v.CellValue()
+				}
+			}
+			r = resolved
+		}
+		ret.push(r)
+	}
+	return ret
+}
@@ -0,0 +1,51 @@
+package tablewriter
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestTable_AppendLazyRow(t *testing.T) {
+	var calls int
+	counter := CellFunc(func() string {
+		calls++
+		return strconv.Itoa(calls)
+	})
+	tbl := newTestTable([]string{"metric", "value"}, [][]string{{"fixed", "0"}})
+	if err := tbl.AppendLazyRow([]CellValuer{CellText("requests"), counter}); err != nil {
+		t.Fatalf("Table.AppendLazyRow() error = %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("Table.AppendLazyRow() evaluated cells before rendering")
+	}
+	for _, want := range []string{"1", "2"} {
+		v := tbl.view()
+		if got := v.rows.at(2).cells; !reflect.DeepEqual(got, []string{"requests", want}) {
+			t.Errorf("Table.view().rows.at(2).cells -> %v, want %v", got, []string{"requests", want})
+		}
+	}
+	if err := tbl.AppendLazyRow([]CellValuer{nil}); err == nil {
+		t.Errorf("Table.AppendLazyRow() returned nil error for wrong shape")
+	}
+
+	tbl.SetCell(1, 1, "fixed")
+	tbl.RemoveColumn(0)
+	tbl.AppendColumn("new", []string{"x", "y"})
+	if got := tbl.view().rows.at(2).cells; !reflect.DeepEqual(got, []string{"fixed", "y"}) {
+		t.Errorf("Table.view().rows.at(2).cells after edits -> %v, want %v", got, []string{"fixed", "y"})
+	}
+}
+
+func TestTable_AppendLazyRow_transpose(t *testing.T) {
+	tbl := newTestTable(nil, [][]string{{"a", "b"}})
+	tbl.AppendLazyRow([]CellValuer{CellText("c"), CellFunc(func() string { return "d" })})
+	tbl.Transpose()
+	want := [][]string{{"a", "c"}, {"b", "d"}}
+	v := tbl.view()
+	for i := range want {
+		if got := v.rows.at(i).cells; !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("Table.view().rows.at(%d).cells -> %v, want %v", i, got, want[i])
+		}
+	}
+}
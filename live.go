@@ -0,0 +1,59 @@
+package tablewriter
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// ANSI control sequences for redrawing a table in place
+const (
+	ansiEraseLine  = "\x1b[K"
+	ansiEraseBelow = "\x1b[J"
+)
+
+// A LiveTable wraps a Table so that it can be re-rendered in place on an ANSI-capable terminal,
+// e.g., to display a continuously updating table of job statuses.
+// All Table methods may be called on a LiveTable. Call Refresh (rather than Render) to draw the table.
+type LiveTable struct {
+	*Table
+	// numLines is the number of lines written by the most recent Refresh
+	numLines int
+	buf      bytes.Buffer
+}
+
+// NewLiveTable wraps `tbl` for rendering in place. The table must write to a terminal.
+func NewLiveTable(tbl *Table) *LiveTable {
+	return &LiveTable{Table: tbl}
+}
+
+// Refresh draws the current contents of the table, replacing the table drawn by the previous call to Refresh.
+// The new table is written with a single call to Write, and each line is overwritten rather than cleared first to avoid flicker.
+func (lt *LiveTable) Refresh() error {
+	b, err := lt.renderBytes()
+	if err != nil {
+		return fmt.Errorf("lt.Refresh(): %v", err)
+	}
+	lt.buf.Reset()
+	// move to the first line of the previous table
+	if lt.numLines > 0 {
+		lt.buf.WriteString(cursorUp(lt.numLines))
+		lt.buf.WriteByte('\r')
+	}
+	// erase any leftover text at the end of each line
+	newline := []byte(lt.newline())
+	lt.buf.Write(bytes.ReplaceAll(b, newline, append([]byte(ansiEraseLine), newline...)))
+	// erase any lines left over from a taller previous table
+	lt.buf.WriteString(ansiEraseBelow)
+	_, err = lt.w.Write(lt.buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("lt.Refresh(): %v", err)
+	}
+	lt.numLines = bytes.Count(b, newline)
+	return nil
+}
+
+// cursorUp returns the control sequence that moves the cursor up `n` lines.
+func cursorUp(n int) string {
+	return "\x1b[" + strconv.Itoa(n) + "A"
+}
@@ -0,0 +1,86 @@
+package tablewriter
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLiveTable_Refresh(t *testing.T) {
+	w := &bytes.Buffer{}
+	lt := NewLiveTable(NewTable(w))
+	lt.AppendRow([]string{"foo"})
+	if err := lt.Refresh(); err != nil {
+		t.Fatalf("LiveTable.Refresh() error = %v", err)
+	}
+	want := "" +
+		"+-----+\x1b[K\n" +
+		"| foo |\x1b[K\n" +
+		"+-----+\x1b[K\n" +
+		"\x1b[J"
+	if got := w.String(); got != want {
+		t.Errorf("LiveTable.Refresh() first call -> %q, want %q", got, want)
+	}
+
+	w.Reset()
+	lt.ClearRows()
+	lt.AppendRows([][]string{{"bar"}, {"baz"}})
+	if err := lt.Refresh(); err != nil {
+		t.Fatalf("LiveTable.Refresh() error = %v", err)
+	}
+	want = "" +
+		"\x1b[3A\r" +
+		"+-----+\x1b[K\n" +
+		"| bar |\x1b[K\n" +
+		"| baz |\x1b[K\n" +
+		"+-----+\x1b[K\n" +
+		"\x1b[J"
+	if got := w.String(); got != want {
+		t.Errorf("LiveTable.Refresh() second call -> %q, want %q", got, want)
+	}
+	if lt.numLines != 4 {
+		t.Errorf("LiveTable.Refresh().numLines -> %v, want %v", lt.numLines, 4)
+	}
+}
+
+func TestLiveTable_Refresh_crlf(t *testing.T) {
+	w := &bytes.Buffer{}
+	lt := NewLiveTable(NewTable(w))
+	lt.SetLineEnding(LineEndingCRLF)
+	lt.AppendRow([]string{"foo"})
+	if err := lt.Refresh(); err != nil {
+		t.Fatalf("LiveTable.Refresh() error = %v", err)
+	}
+	want := "" +
+		"+-----+\x1b[K\r\n" +
+		"| foo |\x1b[K\r\n" +
+		"+-----+\x1b[K\r\n" +
+		"\x1b[J"
+	if got := w.String(); got != want {
+		t.Errorf("LiveTable.Refresh() -> %q, want %q", got, want)
+	}
+	if lt.numLines != 3 {
+		t.Errorf("LiveTable.Refresh().numLines -> %v, want %v", lt.numLines, 3)
+	}
+}
+
+func TestLiveTable_Refresh_fail(t *testing.T) {
+	tests := []struct {
+		name string
+		tbl  *Table
+	}{
+		{"empty table", NewTable(&bytes.Buffer{})},
+		{"bad writer", newTestTable(nil, [][]string{{"foo"}})},
+	}
+	tests[1].tbl.w = testBadWriter("")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := NewLiveTable(tt.tbl)
+			if err := lt.Refresh(); err == nil {
+				t.Errorf("LiveTable.Refresh() error = nil, want error")
+			}
+			if lt.numLines != 0 {
+				t.Errorf("LiveTable.Refresh().numLines -> %v, want 0", lt.numLines)
+			}
+		})
+	}
+}
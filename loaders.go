@@ -0,0 +1,207 @@
+package tablewriter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// A KeyOrder configures the order of the columns created from the keys of map-based records,
+// so that repeated renders of the same data do not shuffle columns between runs.
+type KeyOrder int
+
+const (
+	// OrderInsertion orders keys by their first appearance in the source data.
+	// Go maps have no insertion order, so AppendMaps falls back to alphabetical order.
+	OrderInsertion KeyOrder = iota
+	// OrderAlphabetical orders keys alphabetically.
+	OrderAlphabetical
+	// OrderExplicit orders keys as listed by the caller. Any unlisted keys follow in alphabetical order.
+	OrderExplicit
+)
+
+// AppendMaps appends one row per record in `records`, with one column per key ordered according to `order`
+// (`keys` lists the key order for OrderExplicit, and is ignored otherwise). Missing keys are empty cells.
+// If the table is empty, a header row of keys is appended first.
+// Otherwise keys are matched to the names in the table's final header row, as in AppendTable with ColumnsUnion.
+func (tbl *Table) AppendMaps(records []map[string]string, order KeyOrder, keys ...string) error {
+	var seen []string
+	for _, record := range records {
+		for key := range record {
+			seen = append(seen, key)
+		}
+	}
+	// map iteration order is random, so there is no insertion order to preserve
+	if order == OrderInsertion {
+		order = OrderAlphabetical
+	}
+	err := tbl.appendRecords(orderKeys(seen, order, keys), records)
+	if err != nil {
+		return fmt.Errorf("tbl.AppendMaps(): %v", err)
+	}
+	return nil
+}
+
+// AppendJSON appends one row per object in a JSON array of objects read from `r`, with one column per key ordered according to `order`
+// (`keys` lists the key order for OrderExplicit, and is ignored otherwise). Missing keys and null values are empty cells.
+// Strings are unquoted, and any other values (including nested arrays and objects) appear as compact JSON.
+// If the table is empty, a header row of keys is appended first.
+// Otherwise keys are matched to the names in the table's final header row, as in AppendTable with ColumnsUnion.
+func (tbl *Table) AppendJSON(r io.Reader, order KeyOrder, keys ...string) error {
+	seen, records, err := decodeJSONObjects(r)
+	if err != nil {
+		return fmt.Errorf("tbl.AppendJSON(): %v", err)
+	}
+	err = tbl.appendRecords(orderKeys(seen, order, keys), records)
+	if err != nil {
+		return fmt.Errorf("tbl.AppendJSON(): %v", err)
+	}
+	return nil
+}
+
+// AppendRowMap appends a non-header row with each value in `row` placed in the column whose name in the final header row matches its key,
+// as configured by MatchColumnNamesExactly. Columns without a key are empty cells.
+// Returns an error if the table has no header rows, or if any key matches no column (unless IgnoreUnknownKeys is enabled).
+func (tbl *Table) AppendRowMap(row map[string]string) error {
+	if tbl.numHeaderRows == 0 {
+		return fmt.Errorf("tbl.AppendRowMap(): table must have at least 1 header row")
+	}
+	names := tbl.rows.at(tbl.numHeaderRows - 1).cells
+	cells := make([]string, len(names))
+	var unknown []string
+	for key, value := range row {
+		k := tbl.matchName(names, key)
+		if k == -1 {
+			unknown = append(unknown, key)
+			continue
+		}
+		cells[k] = value
+	}
+	if len(unknown) > 0 && !tbl.ignoreUnknownKeys {
+		sort.Strings(unknown)
+		return fmt.Errorf("tbl.AppendRowMap(): unknown columns %q", unknown)
+	}
+	tbl.rows.append(record{cells: cells})
+	return nil
+}
+
+// IgnoreUnknownKeys causes AppendRowMap to discard values whose keys match no column, instead of returning an error.
+func (tbl *Table) IgnoreUnknownKeys() {
+	tbl.ignoreUnknownKeys = true
+}
+
+// appendRecords appends `records` to the table as rows with columns named `keys`.
+func (tbl *Table) appendRecords(keys []string, records []map[string]string) error {
+	if len(records) == 0 {
+		return nil
+	}
+	loaded := &Table{}
+	loaded.rows.append(record{cells: keys})
+	loaded.numHeaderRows = 1
+	for _, r := range records {
+		cells := make([]string, len(keys))
+		for k, key := range keys {
+			cells[k] = r[key]
+		}
+		loaded.rows.push(record{cells: cells})
+	}
+	return tbl.AppendTable(loaded, ColumnsUnion)
+}
+
+// orderKeys returns the distinct keys in `seen` (in order of first appearance) ordered according to `order`.
+func orderKeys(seen []string, order KeyOrder, explicit []string) []string {
+	var distinct []string
+	present := make(map[string]bool)
+	for _, key := range seen {
+		if !present[key] {
+			present[key] = true
+			distinct = append(distinct, key)
+		}
+	}
+	switch order {
+	case OrderAlphabetical:
+		sort.Strings(distinct)
+	case OrderExplicit:
+		var ret []string
+		listed := make(map[string]bool)
+		for _, key := range explicit {
+			if present[key] && !listed[key] {
+				listed[key] = true
+				ret = append(ret, key)
+			}
+		}
+		var rest []string
+		for _, key := range distinct {
+			if !listed[key] {
+				rest = append(rest, key)
+			}
+		}
+		sort.Strings(rest)
+		return append(ret, rest...)
+	}
+	return distinct
+}
+
+// decodeJSONObjects decodes a JSON array of objects, returning every key in order of appearance along with the stringified records.
+func decodeJSONObjects(r io.Reader) (keys []string, records []map[string]string, err error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := expectDelim(dec, '['); err != nil {
+		return nil, nil, err
+	}
+	for dec.More() {
+		if err := expectDelim(dec, '{'); err != nil {
+			return nil, nil, err
+		}
+		rec := make(map[string]string)
+		for dec.More() {
+			t, err := dec.Token()
+			if err != nil {
+				return nil, nil, err
+			}
+			key := t.(string)
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return nil, nil, err
+			}
+			keys = append(keys, key)
+			rec[key] = stringifyJSONValue(value)
+		}
+		if err := expectDelim(dec, '}'); err != nil {
+			return nil, nil, err
+		}
+		records = append(records, rec)
+	}
+	if err := expectDelim(dec, ']'); err != nil {
+		return nil, nil, err
+	}
+	return keys, records, nil
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := t.(json.Delim); !ok || d != want {
+		return fmt.Errorf("expected %v in JSON input, got %v", want, t)
+	}
+	return nil
+}
+
+func stringifyJSONValue(value json.RawMessage) string {
+	var s string
+	if json.Unmarshal(value, &s) == nil {
+		return s
+	}
+	if string(value) == "null" {
+		return ""
+	}
+	var buf bytes.Buffer
+	if json.Compact(&buf, value) != nil {
+		return string(value)
+	}
+	return buf.String()
+}
@@ -0,0 +1,122 @@
+package tablewriter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTable_AppendJSON(t *testing.T) {
+	input := `[{"name": "foo", "count": 2, "tags": ["a", "b"]}, {"count": 3, "name": "bar", "extra": null, "ok": true}]`
+	tests := []struct {
+		name    string
+		order   KeyOrder
+		keys    []string
+		want    [][]string
+		wantErr bool
+	}{
+		{"insertion", OrderInsertion, nil, [][]string{
+			{"name", "count", "tags", "extra", "ok"},
+			{"foo", "2", `["a","b"]`, "", ""},
+			{"bar", "3", "", "", "true"}}, false},
+		{"alphabetical", OrderAlphabetical, nil, [][]string{
+			{"count", "extra", "name", "ok", "tags"},
+			{"2", "", "foo", "", `["a","b"]`},
+			{"3", "", "bar", "true", ""}}, false},
+		{"explicit", OrderExplicit, []string{"ok", "name", "missing"}, [][]string{
+			{"ok", "name", "count", "extra", "tags"},
+			{"", "foo", "2", "", `["a","b"]`},
+			{"true", "bar", "3", "", ""}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := NewTable(nil)
+			if err := tbl.AppendJSON(strings.NewReader(input), tt.order, tt.keys...); (err != nil) != tt.wantErr {
+				t.Fatalf("Table.AppendJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := tbl.rows.all(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Table.AppendJSON().rows -> %v, want %v", got, tt.want)
+			}
+			if tbl.numHeaderRows != 1 {
+				t.Errorf("Table.AppendJSON().numHeaderRows -> %v, want 1", tbl.numHeaderRows)
+			}
+		})
+	}
+}
+
+func TestTable_AppendJSON_errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"not an array", `{"foo": 1}`},
+		{"not objects", `[1, 2]`},
+		{"truncated", `[{"foo": 1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := NewTable(nil).AppendJSON(strings.NewReader(tt.input), OrderInsertion); err == nil {
+				t.Errorf("Table.AppendJSON() returned nil error, want error")
+			}
+		})
+	}
+}
+
+func TestTable_AppendMaps(t *testing.T) {
+	records := []map[string]string{{"b": "1", "a": "2"}, {"c": "3"}}
+	tbl := NewTable(nil)
+	if err := tbl.AppendMaps(records, OrderInsertion); err != nil {
+		t.Fatalf("Table.AppendMaps() error = %v", err)
+	}
+	want := [][]string{{"a", "b", "c"}, {"2", "1", ""}, {"", "", "3"}}
+	if got := tbl.rows.all(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.AppendMaps().rows -> %v, want %v", got, want)
+	}
+	// subsequent records are matched to the existing columns by name
+	if err := tbl.AppendMaps([]map[string]string{{"C": "4", "d": "5"}}, OrderAlphabetical); err != nil {
+		t.Fatalf("Table.AppendMaps() error = %v", err)
+	}
+	want = [][]string{{"a", "b", "c", "d"}, {"2", "1", "", ""}, {"", "", "3", ""}, {"", "", "4", "5"}}
+	if got := tbl.rows.all(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.AppendMaps().rows -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_AppendRowMap(t *testing.T) {
+	tests := []struct {
+		name          string
+		row           map[string]string
+		ignoreUnknown bool
+		want          []string
+		wantErr       bool
+	}{
+		{"all keys", map[string]string{"name": "foo", "Last Login": "today"}, false, []string{"foo", "today"}, false},
+		{"missing key", map[string]string{"last login": "today"}, false, []string{"", "today"}, false},
+		{"unknown key", map[string]string{"name": "foo", "age": "3"}, false, nil, true},
+		{"ignore unknown key", map[string]string{"name": "foo", "age": "3"}, true, []string{"foo", ""}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"name", "last login"}, nil)
+			if tt.ignoreUnknown {
+				tbl.IgnoreUnknownKeys()
+			}
+			err := tbl.AppendRowMap(tt.row)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Table.AppendRowMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if tbl.NumRows() != 0 {
+					t.Errorf("Table.AppendRowMap() appended a row despite an error")
+				}
+				return
+			}
+			if got := tbl.Rows(); !reflect.DeepEqual(got, [][]string{tt.want}) {
+				t.Errorf("Table.AppendRowMap().Rows() -> %v, want %v", got, [][]string{tt.want})
+			}
+		})
+	}
+	if err := NewTable(nil).AppendRowMap(map[string]string{"a": "b"}); err == nil {
+		t.Errorf("Table.AppendRowMap() returned nil error for a table without headers")
+	}
+}
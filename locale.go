@@ -0,0 +1,88 @@
+package tablewriter
+
+import (
+	"strings"
+	"time"
+)
+
+// A Locale controls how formatted numbers and timestamps are written.
+// It applies to the non-header cells of columns that display percentages (see SetColumnPercentOfTotal)
+// or are configured with KindCount, KindPercent, KindNumber, or KindTimestamp.
+type Locale struct {
+	// DecimalSeparator separates the integer and fractional parts of a number (e.g., "," for de-DE).
+	DecimalSeparator string
+	// GroupSeparator separates every group of 3 digits in the integer part of a number (e.g., "." for de-DE).
+	GroupSeparator string
+	// TimestampLayout, if not empty, is the time.Format layout of KindTimestamp cells.
+	TimestampLayout string
+	// FormatNumber, if not nil, formats every number instead of DecimalSeparator and GroupSeparator,
+	// with the same number of decimal places as the unlocalized number.
+	// For full CLDR support, wrap a golang.org/x/text/message.Printer, e.g.:
+	//	p := message.NewPrinter(language.German)
+	//	locale.FormatNumber = func(f float64, decimals int) string {
+	//		return p.Sprint(number.Decimal(f, number.Scale(decimals)))
+	//	}
+	FormatNumber func(value float64, decimals int) string
+}
+
+// Common locales.
+var (
+	// LocaleUS formats 1234567.89 as "1,234,567.89".
+	LocaleUS = Locale{DecimalSeparator: ".", GroupSeparator: ",", TimestampLayout: "2006-01-02 15:04:05"}
+	// LocaleDE formats 1234567.89 as "1.234.567,89".
+	LocaleDE = Locale{DecimalSeparator: ",", GroupSeparator: ".", TimestampLayout: "02.01.2006 15:04:05"}
+	// LocaleFR formats 1234567.89 as "1 234 567,89", grouped with a narrow no-break space.
+	LocaleFR = Locale{DecimalSeparator: ",", GroupSeparator: "\u202f", TimestampLayout: "02/01/2006 15:04:05"}
+)
+
+// SetLocale formats numbers and timestamps according to `locale` at render time.
+// Column widths are computed after formatting.
+func (tbl *Table) SetLocale(locale Locale) {
+	tbl.locale = &locale
+}
+
+// localize rewrites the formatted cell `s` according to the locale, if `c` formats numbers or timestamps.
+// `original` is the cell before formatting.
+func (locale *Locale) localize(s string, original string, c columnSettings) string {
+	switch {
+	case c.kind == KindTimestamp:
+		if locale.TimestampLayout == "" {
+			return s
+		}
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(original))
+		if err != nil {
+			return s
+		}
+		return t.Format(locale.TimestampLayout)
+	case c.percent != nil || c.kind == KindCount || c.kind == KindPercent || c.kind == KindNumber:
+		return locale.formatNumber(s)
+	}
+	return s
+}
+
+// formatNumber rewrites the number `s`, written with "." as the decimal separator and optional "," group separators
+// and "%" suffix, according to the locale. Cells that are not plain decimal numbers are left unchanged.
+func (locale *Locale) formatNumber(s string) string {
+	number := strings.TrimSuffix(s, "%")
+	suffix := s[len(number):]
+	integer, fraction := strings.ReplaceAll(number, ",", ""), ""
+	// only plain decimals are regrouped, so that exponents, hex, and special values (e.g., "1e6" and "Inf") are left as written
+	if !isPlainDecimal(integer) {
+		return s
+	}
+	f, ok := parseNumber(number)
+	if !ok {
+		return s
+	}
+	if i := strings.IndexByte(integer, '.'); i != -1 {
+		integer, fraction = integer[:i], integer[i+1:]
+	}
+	if locale.FormatNumber != nil {
+		return locale.FormatNumber(f, len(fraction)) + suffix
+	}
+	ret := groupDigits(integer, locale.GroupSeparator)
+	if fraction != "" {
+		ret += locale.DecimalSeparator + fraction
+	}
+	return ret + suffix
+}
@@ -0,0 +1,60 @@
+package tablewriter
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestLocale_formatNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale Locale
+		s      string
+		want   string
+	}{
+		{"de-DE", LocaleDE, "1,234,567.89", "1.234.567,89"},
+		{"de-DE percent", LocaleDE, "12.5%", "12,5%"},
+		{"de-DE negative", LocaleDE, "-1234", "-1.234"},
+		{"fr-FR", LocaleFR, "1234567.89", "1\u202f234\u202f567,89"},
+		{"en-US", LocaleUS, "1234567.89", "1,234,567.89"},
+		{"not a number", LocaleDE, "n/a", "n/a"},
+		{"en-US exponent", LocaleUS, "123456e2", "123456e2"},
+		{"de-DE exponent", LocaleDE, "123456e2", "123456e2"},
+		{"hex", LocaleDE, "0x1p-2", "0x1p-2"},
+		{"infinity", LocaleDE, "Inf", "Inf"},
+		{"NaN percent", LocaleDE, "NaN%", "NaN%"},
+		{"custom", Locale{FormatNumber: func(f float64, decimals int) string {
+			return "<" + strconv.FormatFloat(f, 'f', decimals+1, 64) + ">"
+		}}, "1,234.5%", "<1234.50>%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.formatNumber(tt.s); got != tt.want {
+				t.Errorf("Locale.formatNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetLocale(t *testing.T) {
+	tbl := newTestTable([]string{"name", "amount", "share", "at"}, [][]string{
+		{"foo", "1234567.89", "0.5", "2020-03-27T15:04:05Z"},
+		{"bar", "5", "0.25", "n/a"},
+	})
+	tbl.SetColumnKinds(KindName, KindNumber, KindPercent, KindTimestamp)
+	tbl.SetLocale(LocaleDE)
+	want := "" +
+		"+------+--------------+-------+---------------------+\n" +
+		"| name |    amount    | share |         at          |\n" +
+		"|------|--------------|-------|---------------------|\n" +
+		"| foo  | 1.234.567,89 | 50,0% | 27.03.2020 15:04:05 |\n" +
+		"| bar  |            5 | 25,0% | n/a                 |\n" +
+		"+------+--------------+-------+---------------------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,151 @@
+package tablewriter
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A MergePosition configures which row in a group of merged repeat values displays the group's value.
+type MergePosition int
+
+const (
+	// MergeFirst displays the value on the first row of the group.
+	MergeFirst MergePosition = iota
+	// MergeMiddle displays the value on the middle row of the group (the upper middle row, if the group has an even number of rows),
+	// which reads better for tall groups.
+	MergeMiddle
+)
+
+// A MergeScope configures which rows a group of merged repeat values may span.
+type MergeScope int
+
+const (
+	// MergeScopeSection merges contiguous repeat values within each section of rows between dividers.
+	MergeScopeSection MergeScope = iota
+	// MergeScopeTable merges contiguous repeat values across the whole table, including across dividers.
+	MergeScopeTable
+	// MergeScopeNested merges contiguous repeat values within each section, and within the group of the column to their left,
+	// so that a repeat value in a new group of a parent column (e.g., a label level) is displayed again.
+	MergeScopeNested
+)
+
+// SetMergeScope sets which rows a group of merged repeat values may span to `scope`.
+// It has no effect unless MergeRepeats is enabled.
+// (Default: MergeScopeSection).
+func (tbl *Table) SetMergeScope(scope MergeScope) {
+	tbl.mergeScope = scope
+}
+
+// SetMergePosition sets the row on which each group of merged repeat values is displayed to `position`.
+// It has no effect unless MergeRepeats is enabled.
+// (Default: MergeFirst).
+func (tbl *Table) SetMergePosition(position MergePosition) {
+	tbl.mergePosition = position
+}
+
+// AnnotateMergeCounts appends the number of rows in each group of merged repeat values to the value, e.g., "api (12)",
+// so grouped output can be summarized at a glance. Groups of a single row and empty values are not annotated.
+// Column widths include the annotations. It has no effect unless MergeRepeats is enabled.
+func (tbl *Table) AnnotateMergeCounts() {
+	tbl.mergeCounts = true
+}
+
+// startsMergeSection returns true if the non-header row at position `i` follows a divider that ends any groups of merged repeat values.
+func (tbl *Table) startsMergeSection(i int) bool {
+	if tbl.mergeScope == MergeScopeTable {
+		return false
+	}
+	body := i - tbl.numHeaderRows
+	d := sort.SearchInts(tbl.dividers, body)
+	return body > 0 && d < len(tbl.dividers) && tbl.dividers[d] == body
+}
+
+// mergeGroups calls `fn` for every group of merged repeat values in column `k`, spanning the non-header rows [start, end),
+// according to the merge scope. `cell` returns the value at row `i` in column `k`.
+func (tbl *Table) mergeGroups(cell func(i, k int) string, fn func(k, start, end int)) {
+	numRows := tbl.rows.len()
+	if numRows <= tbl.numHeaderRows {
+		return
+	}
+	// breaks[i] is true if row i starts a new group in every column not yet visited
+	breaks := make([]bool, numRows)
+	for i := tbl.numHeaderRows + 1; i < numRows; i++ {
+		breaks[i] = tbl.startsMergeSection(i)
+	}
+	for k := range tbl.rows.at(0).cells {
+		start := tbl.numHeaderRows
+		for i := start + 1; i <= numRows; i++ {
+			if i < numRows && !breaks[i] && cell(i, k) == cell(start, k) {
+				continue
+			}
+			fn(k, start, i)
+			if tbl.mergeScope == MergeScopeNested && i < numRows {
+				breaks[i] = true
+			}
+			start = i
+		}
+	}
+}
+
+// annotateMergeCounts appends the group size to every cell in each group of 2 or more repeat values among the non-header rows of a view,
+// so that the annotated cells still merge together. The row at position `elision` (-1 if none) is never annotated.
+// Expects the view to own its rows, but not the cells within them.
+func (tbl *Table) annotateMergeCounts(elision int) {
+	numRows := tbl.rows.len()
+	if numRows <= tbl.numHeaderRows {
+		return
+	}
+	// counts holds the size of the group containing each cell, if it should be annotated
+	counts := make([][]int, numRows)
+	cell := func(i, k int) string {
+		if i == elision {
+			return ""
+		}
+		return tbl.rows.at(i).cells[k]
+	}
+	tbl.mergeGroups(cell, func(k, start, end int) {
+		if end-start < 2 || cell(start, k) == "" {
+			return
+		}
+		for i := start; i < end; i++ {
+			if counts[i] == nil {
+				counts[i] = make([]int, len(tbl.rows.at(i).cells))
+			}
+			counts[i][k] = end - start
+		}
+	})
+	for i := range counts {
+		if counts[i] == nil {
+			continue
+		}
+		row := tbl.rows.at(i)
+		cells := make([]string, len(row.cells))
+		for k := range cells {
+			cells[k] = row.cells[k]
+			if counts[i][k] > 0 {
+				cells[k] = fmt.Sprintf("%s (%d)", cells[k], counts[i][k])
+			}
+		}
+		tbl.rows.set(i, record{cells: cells, styles: row.styles})
+	}
+}
+
+// mergeMask returns, for each non-header row in `tbl`, whether each cell is displayed when repeat values are merged on their middle row.
+func (tbl *Table) mergeMask() [][]bool {
+	numRows := tbl.rows.len() - tbl.numHeaderRows
+	if numRows <= 0 {
+		return nil
+	}
+	numCols := len(tbl.rows.at(0).cells)
+	ret := make([][]bool, numRows)
+	for i := range ret {
+		ret[i] = make([]bool, numCols)
+	}
+	cell := func(i, k int) string {
+		return tbl.rows.at(i).cells[k]
+	}
+	tbl.mergeGroups(cell, func(k, start, end int) {
+		ret[start+(end-start-1)/2-tbl.numHeaderRows][k] = true
+	})
+	return ret
+}
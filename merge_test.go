@@ -0,0 +1,122 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTable_mergeMask(t *testing.T) {
+	tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"a", "x"}, {"a", "x"}, {"a", "y"}, {"b", "y"}, {"b", "y"}})
+	want := [][]bool{{false, true}, {true, false}, {false, false}, {true, true}, {false, false}}
+	if got := tbl.mergeMask(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.mergeMask() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_SetMergePosition(t *testing.T) {
+	tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"a", "1"}, {"a", "2"}, {"a", "3"}, {"b", "4"}})
+	tbl.MergeRepeats()
+	tbl.SetMergePosition(MergeMiddle)
+	want := "" +
+		"+-----+-----+\n" +
+		"| foo | bar |\n" +
+		"|-----|-----|\n" +
+		"|     |  1  |\n" +
+		"|  a  |  2  |\n" +
+		"|     |  3  |\n" +
+		"|  b  |  4  |\n" +
+		"+-----+-----+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_AnnotateMergeCounts(t *testing.T) {
+	tbl := newTestTable([]string{"svc", "code"}, [][]string{{"api", "200"}, {"api", "200"}, {"api", "500"}, {"web", ""}, {"web", ""}})
+	tbl.MergeRepeats()
+	tbl.AnnotateMergeCounts()
+	tbl.SetAlignment(AlignLeft)
+	want := "" +
+		"+---------+---------+\n" +
+		"|   svc   |  code   |\n" +
+		"|---------|---------|\n" +
+		"| api (3) | 200 (2) |\n" +
+		"|         |         |\n" +
+		"|         | 500     |\n" +
+		"| web (2) |         |\n" +
+		"|         |         |\n" +
+		"+---------+---------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	if got := tbl.rows.at(1).cells[0]; got != "api" {
+		t.Errorf("Table.render() changed stored cell to %q, want %q", got, "api")
+	}
+}
+
+func TestTable_SetMergeScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    MergeScope
+		position MergePosition
+		want     string
+	}{
+		{"section", MergeScopeSection, MergeFirst, "" +
+			"+---+---+\n" +
+			"| a | x |\n" +
+			"|   |   |\n" +
+			"+---+---+\n" +
+			"| a | x |\n" +
+			"| b |   |\n" +
+			"+---+---+\n"},
+		{"table", MergeScopeTable, MergeFirst, "" +
+			"+---+---+\n" +
+			"| a | x |\n" +
+			"|   |   |\n" +
+			"+---+---+\n" +
+			"|   |   |\n" +
+			"| b |   |\n" +
+			"+---+---+\n"},
+		{"nested", MergeScopeNested, MergeFirst, "" +
+			"+---+---+\n" +
+			"| a | x |\n" +
+			"|   |   |\n" +
+			"+---+---+\n" +
+			"| a | x |\n" +
+			"| b | x |\n" +
+			"+---+---+\n"},
+		{"nested middle", MergeScopeNested, MergeMiddle, "" +
+			"+---+---+\n" +
+			"| a | x |\n" +
+			"|   |   |\n" +
+			"+---+---+\n" +
+			"| a | x |\n" +
+			"| b | x |\n" +
+			"+---+---+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable(nil, [][]string{{"a", "x"}, {"a", "x"}})
+			tbl.AppendDivider()
+			tbl.AppendRows([][]string{{"a", "x"}, {"b", "x"}})
+			tbl.MergeRepeats()
+			tbl.SetMergeScope(tt.scope)
+			tbl.SetMergePosition(tt.position)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package tablewriter
+
+import "strings"
+
+// A MetadataPosition configures where a table's metadata is rendered.
+type MetadataPosition int
+
+const (
+	// MetadataHidden does not render metadata.
+	MetadataHidden MetadataPosition = iota
+	// MetadataAbove renders metadata above the table.
+	MetadataAbove
+	// MetadataBelow renders metadata below the table.
+	MetadataBelow
+)
+
+// a metadataEntry is a single key/value pair of table metadata.
+type metadataEntry struct {
+	key, value string
+}
+
+// SetMetadata attaches `value` to the table under `key` (e.g., "source", "generated at"), replacing any existing value.
+// Keys are rendered in the order they were first set.
+func (tbl *Table) SetMetadata(key, value string) {
+	for i := range tbl.metadata {
+		if tbl.metadata[i].key == key {
+			tbl.metadata[i].value = value
+			return
+		}
+	}
+	tbl.metadata = append(tbl.metadata, metadataEntry{key, value})
+}
+
+// Metadata returns the value attached to the table under `key`, and whether the key exists.
+func (tbl *Table) Metadata(key string) (string, bool) {
+	for i := range tbl.metadata {
+		if tbl.metadata[i].key == key {
+			return tbl.metadata[i].value, true
+		}
+	}
+	return "", false
+}
+
+// ShowMetadata renders the table's metadata at `position` relative to the table (default: MetadataHidden).
+// Every output format includes the metadata: Render as plain "key: value" lines, and RenderAsciiDoc, RenderReST, and RenderOrg
+// as comment lines in the syntax of their format. Custom renderers receive it in TableModel.
+func (tbl *Table) ShowMetadata(position MetadataPosition) {
+	tbl.metadataPosition = position
+}
+
+// stringifyMetadata returns the metadata as "key: value" lines with the values aligned,
+// or an empty string if there is no metadata.
+func (tbl *Table) stringifyMetadata() string {
+	return tbl.metadataLines("")
+}
+
+// metadataLines returns the metadata as "key: value" lines with the values aligned, each starting with `prefix`
+// (e.g., a comment marker), or an empty string if there is no metadata.
+func (tbl *Table) metadataLines(prefix string) string {
+	var keyWidth int
+	for _, entry := range tbl.metadata {
+		if w := runeWidth(entry.key); w > keyWidth {
+			keyWidth = w
+		}
+	}
+	ret := strings.Builder{}
+	for _, entry := range tbl.metadata {
+		ret.WriteString(prefix)
+		ret.WriteString(entry.key)
+		ret.WriteString(":")
+		ret.WriteString(strings.Repeat(" ", 1+keyWidth-runeWidth(entry.key)))
+		ret.WriteString(entry.value)
+		ret.WriteString("\n")
+	}
+	return ret.String()
+}
+
+// withMetadata returns the rendered table `s` with the metadata, as lines starting with `prefix`, at the configured position.
+// `separator` is written between the metadata and the table.
+func (tbl *Table) withMetadata(s string, prefix, separator string) string {
+	if tbl.metadataPosition == MetadataHidden || len(tbl.metadata) == 0 {
+		return s
+	}
+	lines := tbl.metadataLines(prefix)
+	if tbl.metadataPosition == MetadataAbove {
+		return lines + separator + s
+	}
+	return s + separator + lines
+}
+
+// A MetadataField is a single key/value pair of table metadata, as provided to a Renderer in TableModel.
+type MetadataField struct {
+	Key, Value string
+}
+
+// metadataFields returns a copy of the metadata in the order the keys were first set.
+func (tbl *Table) metadataFields() []MetadataField {
+	if len(tbl.metadata) == 0 {
+		return nil
+	}
+	ret := make([]MetadataField, len(tbl.metadata))
+	for i, entry := range tbl.metadata {
+		ret[i] = MetadataField{Key: entry.key, Value: entry.value}
+	}
+	return ret
+}
@@ -0,0 +1,140 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTable_SetMetadata(t *testing.T) {
+	tbl := NewTable(nil)
+	tbl.SetMetadata("source", "db")
+	tbl.SetMetadata("query", "select *")
+	tbl.SetMetadata("source", "cache")
+
+	tests := []struct {
+		key       string
+		wantValue string
+		wantOk    bool
+	}{
+		{"source", "cache", true},
+		{"query", "select *", true},
+		{"missing", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, ok := tbl.Metadata(tt.key)
+			if got != tt.wantValue || ok != tt.wantOk {
+				t.Errorf("Table.Metadata() = %v, %v, want %v, %v", got, ok, tt.wantValue, tt.wantOk)
+			}
+		})
+	}
+	if len(tbl.metadata) != 2 {
+		t.Errorf("Table.SetMetadata() -> %d entries, want 2", len(tbl.metadata))
+	}
+}
+
+func TestTable_render_metadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		position MetadataPosition
+		want     string
+	}{
+		{"hidden", MetadataHidden, "" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"+-----+\n"},
+		{"above", MetadataAbove, "" +
+			"source:       db\n" +
+			"generated at: noon\n" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"+-----+\n"},
+		{"below", MetadataBelow, "" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"+-----+\n" +
+			"source:       db\n" +
+			"generated at: noon\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable(nil, [][]string{{"foo"}})
+			tbl.SetMetadata("source", "db")
+			tbl.SetMetadata("generated at", "noon")
+			tbl.ShowMetadata(tt.position)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_formats_metadata(t *testing.T) {
+	tests := []struct {
+		name      string
+		position  MetadataPosition
+		stringify func(*Table) (string, error)
+		want      string
+	}{
+		{"asciidoc above", MetadataAbove, (*Table).stringifyAsciiDoc, "" +
+			"// source:       db\n" +
+			"// generated at: noon\n" +
+			"[cols=\"^\"]\n" +
+			"|===\n" +
+			"|foo\n" +
+			"|===\n"},
+		{"rest above", MetadataAbove, (*Table).stringifyReST, "" +
+			".. source:       db\n" +
+			".. generated at: noon\n" +
+			"\n" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"+-----+\n"},
+		{"rest below", MetadataBelow, (*Table).stringifyReST, "" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"+-----+\n" +
+			"\n" +
+			".. source:       db\n" +
+			".. generated at: noon\n"},
+		{"org below", MetadataBelow, (*Table).stringifyOrg, "" +
+			"| foo |\n" +
+			"# source:       db\n" +
+			"# generated at: noon\n"},
+		{"org hidden", MetadataHidden, (*Table).stringifyOrg, "" +
+			"| foo |\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable(nil, [][]string{{"foo"}})
+			tbl.SetMetadata("source", "db")
+			tbl.SetMetadata("generated at", "noon")
+			tbl.ShowMetadata(tt.position)
+			got, err := tt.stringify(tbl)
+			if err != nil {
+				t.Fatalf("stringify() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("stringify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_Model_metadata(t *testing.T) {
+	tbl := newTestTable(nil, [][]string{{"foo"}})
+	tbl.SetMetadata("source", "db")
+	tbl.ShowMetadata(MetadataBelow)
+	got, err := tbl.Model()
+	if err != nil {
+		t.Fatalf("Table.Model() error = %v", err)
+	}
+	want := []MetadataField{{Key: "source", Value: "db"}}
+	if !reflect.DeepEqual(got.Metadata, want) || got.MetadataPosition != MetadataBelow {
+		t.Errorf("Table.Model() metadata = %v, %v, want %v, %v", got.Metadata, got.MetadataPosition, want, MetadataBelow)
+	}
+}
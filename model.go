@@ -0,0 +1,148 @@
+package tablewriter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ptiger10/tablewriter/layout"
+)
+
+// A Renderer writes a table in a custom output format, so that formats not built into this package
+// can be implemented without forking it.
+type Renderer interface {
+	RenderTable(model *TableModel, w io.Writer) error
+}
+
+// A TableModel is a read-only snapshot of a table as it would be rendered, for use by a Renderer.
+// All render-time transformations (e.g., formatting, computed columns, pinned rows, groups, and footers) except highlights have already been applied,
+// and every slice is a copy that may be modified without affecting the table.
+type TableModel struct {
+	// Headers holds the header rows, and Rows holds the non-header rows. Every row has one cell per column.
+	Headers [][]string
+	Rows    [][]string
+	// Styles holds the style of every cell in Rows, or is nil if no cell is styled.
+	Styles [][]Style
+	// ColumnWidths is the rendered width of every column, excluding padding.
+	ColumnWidths []int
+	// Alignments is the alignment of the non-header cells in every column.
+	Alignments []Alignment
+	// Overflows is how every column handles cells wider than its width (never OverflowDefault).
+	Overflows []Overflow
+	// Dividers holds the positions in Rows before which a dividing line is drawn.
+	Dividers []int
+	// CenterHeaders is true if header cells are centered regardless of Alignments.
+	CenterHeaders bool
+	// NumLabelLevels is the number of label columns, which appear on the side identified by LabelSide.
+	NumLabelLevels int
+	LabelSide      Side
+	// Metadata holds the table's metadata in the order the keys were first set, and MetadataPosition is where it is shown.
+	Metadata         []MetadataField
+	MetadataPosition MetadataPosition
+}
+
+// Model returns a snapshot of the table as it would be rendered.
+func (tbl *Table) Model() (*TableModel, error) {
+	if err := tbl.checkRenderable(); err != nil {
+		return nil, fmt.Errorf("tbl.Model(): %v", err)
+	}
+	v := tbl.plainView()
+	var r renderer
+	if _, err := v.layoutColumns(&r); err != nil {
+		return nil, fmt.Errorf("tbl.Model(): %v", err)
+	}
+	numCols := len(r.colWidths)
+	model := &TableModel{
+		ColumnWidths:     r.colWidths,
+		Alignments:       make([]Alignment, numCols),
+		Overflows:        make([]Overflow, numCols),
+		CenterHeaders:    v.autoCenterHeaders,
+		NumLabelLevels:   v.numLabelLevels,
+		LabelSide:        v.labelSide,
+		Metadata:         v.metadataFields(),
+		MetadataPosition: v.metadataPosition,
+	}
+	for k := 0; k < numCols; k++ {
+		model.Alignments[k] = v.columnAlignment(k)
+		model.Overflows[k] = v.overflow(k)
+	}
+	for i := 0; i < v.rows.len(); i++ {
+		row := copyRecord(v.rows.at(i))
+		if i < v.numHeaderRows {
+			model.Headers = append(model.Headers, row.cells)
+			continue
+		}
+		model.Rows = append(model.Rows, row.cells)
+		if model.Styles != nil || row.styles != nil {
+			if model.Styles == nil {
+				model.Styles = make([][]Style, len(model.Rows)-1, v.rows.len()-v.numHeaderRows)
+			}
+			model.Styles = append(model.Styles, row.styles)
+		}
+	}
+	numRows := len(model.Rows)
+	for _, d := range v.dividers {
+		// dividers are stored in ascending order, and repeated dividers draw a single line
+		if d > 0 && d < numRows && (len(model.Dividers) == 0 || model.Dividers[len(model.Dividers)-1] != d) {
+			model.Dividers = append(model.Dividers, d)
+		}
+	}
+	return model, nil
+}
+
+// RenderWith renders the table with a custom Renderer and writes the results into the table's io.Writer.
+func (tbl *Table) RenderWith(custom Renderer) error {
+	model, err := tbl.Model()
+	if err != nil {
+		return fmt.Errorf("tbl.RenderWith(): %v", err)
+	}
+	if err := custom.RenderTable(model, tbl.w); err != nil {
+		return fmt.Errorf("tbl.RenderWith(): %v", err)
+	}
+	return nil
+}
+
+// Snapshot returns a copy of the rows exactly as they would be rendered, for asserting on the logical content of a table
+// without parsing its rendered output. Header rows come first, unless they are suppressed.
+// All render-time transformations have been applied, including merged repeat values, placeholders, and truncation,
+// but cells that would be wrapped are returned whole, and highlights are not marked. Returns nil if the table cannot be rendered.
+func (tbl *Table) Snapshot() [][]string {
+	if err := tbl.checkRenderable(); err != nil {
+		return nil
+	}
+	v := tbl.plainView()
+	var r renderer
+	if _, err := v.layoutColumns(&r); err != nil {
+		return nil
+	}
+	var mask [][]bool
+	if v.autoMerge && v.mergePosition == MergeMiddle {
+		mask = v.mergeMask()
+	}
+	ret := make([][]string, 0, v.rows.len())
+	for i := 0; i < v.rows.len(); i++ {
+		cells := copyRecord(v.rows.at(i)).cells
+		v.mergeCells(&r, mask, i, cells)
+		if i < v.numHeaderRows && v.suppressed(SuppressHeaders) {
+			continue
+		}
+		for k := range cells {
+			if v.overflow(k) == OverflowTruncate {
+				cells[k] = truncateLines(cells[k], r.colWidths[k])
+			}
+		}
+		ret = append(ret, cells)
+	}
+	return ret
+}
+
+// truncateLines truncates every line in `s` that is wider than `width`.
+func truncateLines(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		if runeWidth(lines[i]) > width {
+			lines[i] = truncateCell(lines[i], width, layout.IsASCII(lines[i]))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,107 @@
+package tablewriter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// csvRenderer is a minimal Renderer that writes every row as comma-separated values.
+type csvRenderer struct{}
+
+func (csvRenderer) RenderTable(model *TableModel, w io.Writer) error {
+	for _, row := range append(model.Headers, model.Rows...) {
+		if _, err := fmt.Fprintln(w, strings.Join(row, ",")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestTable_Model(t *testing.T) {
+	tbl := newTestTable([]string{"name", "n"}, [][]string{{"foo", "1"}})
+	tbl.AppendDivider()
+	tbl.AppendDivider()
+	tbl.AppendRow([]string{"barbaz", "2"})
+	tbl.SetColumnAlignment(1, AlignRight)
+	tbl.EnableAutoIndex()
+	got, err := tbl.Model()
+	if err != nil {
+		t.Fatalf("Table.Model() error = %v", err)
+	}
+	want := &TableModel{
+		Headers:        [][]string{{"", "name", "n"}},
+		Rows:           [][]string{{"1", "foo", "1"}, {"2", "barbaz", "2"}},
+		ColumnWidths:   []int{1, 6, 1},
+		Alignments:     []Alignment{AlignCenter, AlignCenter, AlignRight},
+		Overflows:      []Overflow{OverflowWrap, OverflowWrap, OverflowWrap},
+		Dividers:       []int{1},
+		CenterHeaders:  true,
+		NumLabelLevels: 1,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.Model() -> %+v, want %+v", got, want)
+	}
+	got.Rows[0][1] = "changed"
+	if tbl.rows.at(1).cells[0] != "foo" {
+		t.Errorf("Table.Model() shares cells with the table")
+	}
+	if _, err := NewTable(nil).Model(); err == nil {
+		t.Errorf("Table.Model() on empty table error = nil, want error")
+	}
+}
+
+func TestTable_RenderWith(t *testing.T) {
+	var buf bytes.Buffer
+	tbl := NewTable(&buf)
+	tbl.AppendHeaderRow([]string{"name", "n"})
+	tbl.AppendRow([]string{"foo", "1"})
+	if err := tbl.RenderWith(csvRenderer{}); err != nil {
+		t.Fatalf("Table.RenderWith() error = %v", err)
+	}
+	if want := "name,n\nfoo,1\n"; buf.String() != want {
+		t.Errorf("Table.RenderWith() -> %q, want %q", buf.String(), want)
+	}
+	tbl.w = testBadWriter("")
+	if err := tbl.RenderWith(csvRenderer{}); err == nil {
+		t.Errorf("Table.RenderWith() with bad writer error = nil, want error")
+	}
+}
+
+func TestTable_Snapshot(t *testing.T) {
+	ChangeDefaults(Defaults{MaxColWidth: 6})
+	defer resetDefaults()
+	tbl := newTestTable([]string{"group", "name", "n"}, [][]string{
+		{"a", "foo", "1234"},
+		{"a", "barbazqux", ""},
+		{"b", "baz", "5"},
+	})
+	tbl.MergeRepeats()
+	tbl.SetEmptyCellPlaceholder("-", false)
+	tbl.SetColumnKind(2, KindCount)
+	tbl.SetColumnOverflow(1, OverflowTruncate)
+	want := [][]string{
+		{"group", "name", "n"},
+		{"a", "foo", "1,234"},
+		{"", "bar...", "-"},
+		{"b", "baz", "5"},
+	}
+	if got := tbl.Snapshot(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.Snapshot() -> %v, want %v", got, want)
+	}
+	tbl.Suppress(SuppressHeaders)
+	if got := tbl.Snapshot(); !reflect.DeepEqual(got, want[1:]) {
+		t.Errorf("Table.Snapshot() with suppressed headers -> %v, want %v", got, want[1:])
+	}
+	if got := NewTable(nil).Snapshot(); got != nil {
+		t.Errorf("Table.Snapshot() on empty table -> %v, want nil", got)
+	}
+	invalid := NewTable(nil, WithHeaders("a", "b"), WithHeaders("c"))
+	invalid.AppendRow([]string{"1", "2"})
+	if got := invalid.Snapshot(); got != nil {
+		t.Errorf("Table.Snapshot() with configuration error -> %v, want nil", got)
+	}
+}
@@ -0,0 +1,108 @@
+package tablewriter
+
+import "fmt"
+
+// With applies `opts` to the table in order and returns the table, so that configuration can be chained:
+//
+//	NewTable(os.Stdout).WithAlignment(AlignLeft).WithHeader(headers).MustAppendRows(rows).Render()
+//
+// Each chainable method is equivalent to calling the corresponding setter. Errors from chained methods (e.g., a header row
+// with the wrong number of fields) are recorded rather than returned, and are reported by Err and by every method that renders the table.
+func (tbl *Table) With(opts ...Option) *Table {
+	for _, opt := range opts {
+		opt(tbl)
+	}
+	return tbl
+}
+
+// WithAlignment is like SetAlignment, but returns the table so that calls can be chained.
+func (tbl *Table) WithAlignment(alignment Alignment) *Table {
+	tbl.SetAlignment(alignment)
+	return tbl
+}
+
+// WithColumnAlignment is like SetColumnAlignment, but returns the table so that calls can be chained.
+func (tbl *Table) WithColumnAlignment(col int, alignment Alignment) *Table {
+	tbl.SetColumnAlignment(col, alignment)
+	return tbl
+}
+
+// WithHeader is like AppendHeaderRow, but returns the table so that calls can be chained.
+// An error is recorded and reported by Err and by every method that renders the table.
+func (tbl *Table) WithHeader(row []string) *Table {
+	if err := tbl.AppendHeaderRow(row); err != nil {
+		tbl.recordErr(fmt.Errorf("tbl.WithHeader(): %v", err))
+	}
+	return tbl
+}
+
+// WithMaxColWidth is like SetMaxColumnWidth, but returns the table so that calls can be chained.
+func (tbl *Table) WithMaxColWidth(n int) *Table {
+	tbl.SetMaxColumnWidth(n)
+	return tbl
+}
+
+// WithTotalWidth is like SetTotalWidth, but returns the table so that calls can be chained.
+func (tbl *Table) WithTotalWidth(n int) *Table {
+	tbl.SetTotalWidth(n)
+	return tbl
+}
+
+// WithHeaderStyle is like SetHeaderStyle, but returns the table so that calls can be chained.
+func (tbl *Table) WithHeaderStyle(style Style) *Table {
+	tbl.SetHeaderStyle(style)
+	return tbl
+}
+
+// WithStyle styles every cell in the non-header rows with `style` at render time (see the WithStyle option),
+// and returns the table so that calls can be chained.
+func (tbl *Table) WithStyle(style Style) *Table {
+	return tbl.With(WithStyle(style))
+}
+
+// Err returns the first error recorded while configuring the table with chainable methods or options, or nil if there was none.
+func (tbl *Table) Err() error {
+	return tbl.configErr
+}
+
+// recordErr records `err` unless an earlier error has already been recorded.
+func (tbl *Table) recordErr(err error) {
+	if tbl.configErr == nil {
+		tbl.configErr = err
+	}
+}
+
+// MustAppendHeaderRow is like AppendHeaderRow, but panics if the row cannot be appended, and returns the table so that calls can be chained:
+//
+//	NewTable(os.Stdout).MustAppendHeaderRow(headers).MustAppendRows(rows).MustRender()
+//
+// It is intended for scripts and tests in which a malformed row is a programming error.
+func (tbl *Table) MustAppendHeaderRow(row []string) *Table {
+	if err := tbl.AppendHeaderRow(row); err != nil {
+		panic(err)
+	}
+	return tbl
+}
+
+// MustAppendRow is like AppendRow, but panics if the row cannot be appended, and returns the table so that calls can be chained.
+func (tbl *Table) MustAppendRow(row []string) *Table {
+	if err := tbl.AppendRow(row); err != nil {
+		panic(err)
+	}
+	return tbl
+}
+
+// MustAppendRows is like AppendRows, but panics if any row cannot be appended, and returns the table so that calls can be chained.
+func (tbl *Table) MustAppendRows(rows [][]string) *Table {
+	if err := tbl.AppendRows(rows); err != nil {
+		panic(err)
+	}
+	return tbl
+}
+
+// MustRender is like Render, but panics if the table cannot be rendered or written.
+func (tbl *Table) MustRender() {
+	if err := tbl.Render(); err != nil {
+		panic(err)
+	}
+}
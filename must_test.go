@@ -0,0 +1,80 @@
+package tablewriter
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTable_Must(t *testing.T) {
+	var buf bytes.Buffer
+	NewTable(&buf, WithAlignment(AlignLeft)).
+		MustAppendHeaderRow([]string{"name"}).
+		MustAppendRow([]string{"foo"}).
+		MustAppendRows([][]string{{"barbaz"}}).
+		MustRender()
+	want := "" +
+		"+--------+\n" +
+		"|  name  |\n" +
+		"|--------|\n" +
+		"| foo    |\n" +
+		"| barbaz |\n" +
+		"+--------+\n"
+	if buf.String() != want {
+		t.Errorf("Table.MustRender() -> %v, want %v", buf.String(), want)
+	}
+
+	tests := []struct {
+		name string
+		call func(tbl *Table)
+	}{
+		{"MustAppendHeaderRow", func(tbl *Table) { tbl.MustAppendHeaderRow([]string{"a", "b"}) }},
+		{"MustAppendRow", func(tbl *Table) { tbl.MustAppendRow([]string{"a", "b"}) }},
+		{"MustAppendRows", func(tbl *Table) { tbl.MustAppendRows([][]string{{"a", "b"}}) }},
+		{"MustRender", func(tbl *Table) { NewTable(&buf).MustRender() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Table.%s() did not panic", tt.name)
+				}
+			}()
+			tt.call(newTestTable([]string{"name"}, nil))
+		})
+	}
+}
+
+func TestTable_With(t *testing.T) {
+	var buf bytes.Buffer
+	err := NewTable(&buf).
+		WithAlignment(AlignLeft).
+		WithHeader([]string{"name", "n"}).
+		WithColumnAlignment(1, AlignRight).
+		MustAppendRows([][]string{{"foo", "1"}, {"barbaz", "20"}}).
+		Render()
+	if err != nil {
+		t.Fatalf("Table.Render() error = %v", err)
+	}
+	want := "" +
+		"+--------+----+\n" +
+		"|  name  | n  |\n" +
+		"|--------|----|\n" +
+		"| foo    |  1 |\n" +
+		"| barbaz | 20 |\n" +
+		"+--------+----+\n"
+	if buf.String() != want {
+		t.Errorf("Table.Render() -> %v, want %v", buf.String(), want)
+	}
+
+	tbl := NewTable(&buf).WithHeader([]string{"a"}).WithHeader([]string{"a", "b"}).With(WithMaxColWidth(5))
+	if tbl.Err() == nil {
+		t.Errorf("Table.Err() = nil after Table.WithHeader() with the wrong number of fields, want error")
+	}
+	if tbl.maxWidth != 5 {
+		t.Errorf("Table.With() did not apply options")
+	}
+	tbl.MustAppendRow([]string{"foo"})
+	if err := tbl.Render(); err == nil {
+		t.Errorf("Table.Render() error = nil after a configuration error, want error")
+	}
+}
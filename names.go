@@ -0,0 +1,94 @@
+package tablewriter
+
+import "fmt"
+
+// ColumnIndex returns the position (starting at 0) of the column whose name in the final header row matches `name`,
+// as configured by MatchColumnNamesExactly.
+// Returns an error if the table has no header rows or no column matches.
+func (tbl *Table) ColumnIndex(name string) (int, error) {
+	if tbl.numHeaderRows == 0 {
+		return 0, fmt.Errorf("tbl.ColumnIndex(): table must have at least 1 header row")
+	}
+	k := tbl.matchName(tbl.rows.at(tbl.numHeaderRows-1).cells, name)
+	if k == -1 {
+		return 0, fmt.Errorf("tbl.ColumnIndex(): no column named %q", name)
+	}
+	return k, nil
+}
+
+// A ColumnRef configures the column with a given header name, for setting up wide tables without tracking column positions.
+// The name is resolved when each setting is applied, and settings then move with their column (e.g., in ReorderColumns).
+type ColumnRef struct {
+	tbl  *Table
+	name string
+}
+
+// Column returns a reference to the column named `name` (see ColumnIndex).
+func (tbl *Table) Column(name string) ColumnRef {
+	return ColumnRef{tbl: tbl, name: name}
+}
+
+// Index returns the position of the column, as in ColumnIndex.
+func (c ColumnRef) Index() (int, error) {
+	return c.tbl.ColumnIndex(c.name)
+}
+
+// apply calls `set` with the position of the column, or returns an error naming `method` if the column does not exist.
+func (c ColumnRef) apply(method string, set func(col int)) error {
+	k, err := c.Index()
+	if err != nil {
+		return fmt.Errorf("tbl.Column(%q).%s(): %v", c.name, method, err)
+	}
+	set(k)
+	return nil
+}
+
+// SetAlignment is like SetColumnAlignment.
+func (c ColumnRef) SetAlignment(alignment Alignment) error {
+	return c.apply("SetAlignment", func(col int) { c.tbl.SetColumnAlignment(col, alignment) })
+}
+
+// SetOverflow is like SetColumnOverflow.
+func (c ColumnRef) SetOverflow(overflow Overflow) error {
+	return c.apply("SetOverflow", func(col int) { c.tbl.SetColumnOverflow(col, overflow) })
+}
+
+// SetMinWidth is like SetMinColumnWidth.
+func (c ColumnRef) SetMinWidth(width int) error {
+	return c.apply("SetMinWidth", func(col int) { c.tbl.SetMinColumnWidth(col, width) })
+}
+
+// SetPadding is like SetColumnPadding.
+func (c ColumnRef) SetPadding(left, right int) error {
+	return c.apply("SetPadding", func(col int) { c.tbl.SetColumnPadding(col, left, right) })
+}
+
+// SetKind is like SetColumnKind.
+func (c ColumnRef) SetKind(kind ColumnKind) error {
+	return c.apply("SetKind", func(col int) { c.tbl.SetColumnKind(col, kind) })
+}
+
+// SetType is like SetColumnType.
+func (c ColumnRef) SetType(typ ColumnType) error {
+	return c.apply("SetType", func(col int) { c.tbl.SetColumnType(col, typ) })
+}
+
+// SetPriority is like SetColumnPriority.
+func (c ColumnRef) SetPriority(priority ShrinkPriority) error {
+	return c.apply("SetPriority", func(col int) { c.tbl.SetColumnPriority(col, priority) })
+}
+
+// SetPercentOfTotal is like SetColumnPercentOfTotal.
+func (c ColumnRef) SetPercentOfTotal(precision int) error {
+	return c.apply("SetPercentOfTotal", func(col int) { c.tbl.SetColumnPercentOfTotal(col, precision) })
+}
+
+// AddFooterAggregate is like tbl.AddFooterAggregate.
+func (c ColumnRef) AddFooterAggregate(agg Aggregator) error {
+	return c.apply("AddFooterAggregate", func(col int) { c.tbl.AddFooterAggregate(col, agg) })
+}
+
+// Remove is like RemoveColumn.
+func (c ColumnRef) Remove() error {
+	return c.apply("Remove", func(col int) { c.tbl.RemoveColumn(col) })
+}
@@ -0,0 +1,54 @@
+package tablewriter
+
+import "testing"
+
+func TestTable_ColumnIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		exact   bool
+		column  string
+		want    int
+		wantErr bool
+	}{
+		{"match", []string{"name", "count"}, false, "count", 1, false},
+		{"normalized match", []string{"name", "Last  Login"}, false, "last login", 1, false},
+		{"exact match required", []string{"name", "Last Login"}, true, "last login", 0, true},
+		{"no match", []string{"name"}, false, "count", 0, true},
+		{"no headers", nil, false, "name", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable(tt.headers, nil)
+			if tt.exact {
+				tbl.MatchColumnNamesExactly()
+			}
+			got, err := tbl.ColumnIndex(tt.column)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Table.ColumnIndex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Table.ColumnIndex() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnRef(t *testing.T) {
+	tbl := newTestTable([]string{"name", "count"}, [][]string{{"foo", "1"}})
+	if err := tbl.Column("count").SetAlignment(AlignRight); err != nil {
+		t.Fatalf("ColumnRef.SetAlignment() error = %v", err)
+	}
+	if got := tbl.columnAlignment(1); got != AlignRight {
+		t.Errorf("ColumnRef.SetAlignment() -> alignment %v, want %v", got, AlignRight)
+	}
+	if err := tbl.Column("missing").SetMinWidth(3); err == nil {
+		t.Errorf("ColumnRef.SetMinWidth() returned nil error for a missing column")
+	}
+	if err := tbl.Column("name").Remove(); err != nil {
+		t.Fatalf("ColumnRef.Remove() error = %v", err)
+	}
+	if got := tbl.columnAlignment(0); got != AlignRight {
+		t.Errorf("ColumnRef.Remove() -> alignment of remaining column %v, want %v", got, AlignRight)
+	}
+}
@@ -0,0 +1,47 @@
+package tablewriter
+
+import "fmt"
+
+// An Option configures a table as it is created by NewTable, so that a table can be fully configured in one expression.
+// Each option is equivalent to calling the corresponding setter after creating the table, and options are applied in order.
+type Option func(*Table)
+
+// WithAlignment sets the alignment of every cell in the table (see SetAlignment).
+func WithAlignment(alignment Alignment) Option {
+	return func(tbl *Table) {
+		tbl.SetAlignment(alignment)
+	}
+}
+
+// WithHeaders appends a header row containing `headers` (see AppendHeaderRow).
+// Each use appends another header row. A header row with a different number of fields than the first is not appended,
+// and the error is reported by Err and by the next render.
+func WithHeaders(headers ...string) Option {
+	return func(tbl *Table) {
+		if err := tbl.AppendHeaderRow(headers); err != nil {
+			tbl.recordErr(fmt.Errorf("WithHeaders(): %v", err))
+		}
+	}
+}
+
+// WithMaxColWidth sets the maximum width of the non-header cells in every column of the table (see SetMaxColumnWidth).
+func WithMaxColWidth(n int) Option {
+	return func(tbl *Table) {
+		tbl.SetMaxColumnWidth(n)
+	}
+}
+
+// WithStyle styles every cell in the non-header rows with `style` at render time.
+// It is equivalent to calling SetRowStyler with a styler that returns `style` for every row.
+func WithStyle(style Style) Option {
+	return func(tbl *Table) {
+		tbl.SetRowStyler(func([]string, map[string]string) Style { return style })
+	}
+}
+
+// WithHeaderStyle sets the style of every cell in the header rows (see SetHeaderStyle).
+func WithHeaderStyle(style Style) Option {
+	return func(tbl *Table) {
+		tbl.SetHeaderStyle(style)
+	}
+}
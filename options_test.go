@@ -0,0 +1,50 @@
+package tablewriter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewTable_options(t *testing.T) {
+	tbl := NewTable(nil,
+		WithAlignment(AlignLeft),
+		WithHeaders("name", "description"),
+		WithHeaders("mismatched"),
+		WithMaxColWidth(5),
+		WithStyle(Style{Bold: true}),
+		WithHeaderStyle(Style{Underline: true}),
+	)
+	if tbl.alignment != AlignLeft {
+		t.Errorf("WithAlignment() -> %v, want %v", tbl.alignment, AlignLeft)
+	}
+	if want := [][]string{{"name", "description"}}; !reflect.DeepEqual(tbl.rows.all(), want) || tbl.numHeaderRows != 1 {
+		t.Errorf("WithHeaders() -> %v, want %v", tbl.rows.all(), want)
+	}
+	if err := tbl.Err(); err == nil || !strings.Contains(err.Error(), "WithHeaders()") {
+		t.Errorf("WithHeaders() with mismatched header -> Err() = %v, want WithHeaders() error", err)
+	}
+	if tbl.headerStyle != (Style{Underline: true}) {
+		t.Errorf("WithHeaderStyle() -> %v, want underline", tbl.headerStyle)
+	}
+	tbl.AppendRow([]string{"foo", "bar baz qux"})
+	v := tbl.view()
+	if want := []Style{{Bold: true}, {Bold: true}}; !reflect.DeepEqual(v.rows.at(1).styles, want) {
+		t.Errorf("WithStyle() -> %v, want %v", v.rows.at(1).styles, want)
+	}
+	if got := tbl.resizeColWidths(); !reflect.DeepEqual(got, []int{4, 11}) {
+		t.Errorf("WithMaxColWidth() widths -> %v, want [4 11]", got)
+	}
+}
+
+func TestTable_SetMaxColumnWidth(t *testing.T) {
+	tbl := newTestTable([]string{"a"}, [][]string{{"foo bar"}})
+	tbl.SetMaxColumnWidth(3)
+	if got := tbl.resizeColWidths(); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("Table.SetMaxColumnWidth(3) widths -> %v, want [3]", got)
+	}
+	tbl.SetMaxColumnWidth(0)
+	if got := tbl.resizeColWidths(); !reflect.DeepEqual(got, []int{7}) {
+		t.Errorf("Table.SetMaxColumnWidth(0) widths -> %v, want [7]", got)
+	}
+}
@@ -0,0 +1,124 @@
+package tablewriter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ptiger10/tablewriter/layout"
+)
+
+// Parse reads a table rendered by this package with the current defaults (see ChangeDefaults) from `r`,
+// and returns a new table writing to os.Stdout with the same header rows, body rows, dividers, and label levels (on the left).
+// Cells are trimmed of padding and alignment, ANSI escape sequences are removed, and any lines before the top border
+// or after the bottom border (e.g., metadata or a trailer) are ignored.
+// Each rendered line becomes its own row, so cells wrapped onto several lines are not rejoined, and merged cells are empty.
+// Returns an error if `r` does not contain a table with a top and bottom border.
+func Parse(r io.Reader) (*Table, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimRight(layout.StripANSI(scanner.Text()), "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("tablewriter.Parse(): %v", err)
+	}
+	top, bottom := -1, -1
+	for i, line := range lines {
+		if isDividingLine(line) {
+			if top == -1 {
+				top = i
+			}
+			bottom = i
+		}
+	}
+	if top == -1 || bottom == top {
+		return nil, fmt.Errorf("tablewriter.Parse(): no table found: expected top and bottom borders")
+	}
+	columns, numLabelLevels := parseColumns(lines[top])
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("tablewriter.Parse(): no columns found in top border %q", lines[top])
+	}
+	tbl := NewTable(os.Stdout)
+	tbl.SetLabelLevelCount(numLabelLevels)
+	headerLeft, middleLeft := dividingLeft(dividingHeader, headerEdge), dividingLeft(dividingMiddle, borderEdge)
+	for i := top + 1; i < bottom; i++ {
+		line := lines[i]
+		if !isDividingLine(line) {
+			tbl.rows.append(record{cells: parseCells(line, columns)})
+			continue
+		}
+		if tbl.numHeaderRows == 0 && headerLeft != middleLeft && strings.HasPrefix(line, headerLeft) {
+			tbl.numHeaderRows = tbl.rows.len()
+			continue
+		}
+		tbl.AppendDivider()
+	}
+	return tbl, nil
+}
+
+// dividingLeft returns the left junction symbol of the dividing row at `row`, or `edge` if it has none.
+func dividingLeft(row dividingRow, edge string) string {
+	if junctions[row][0] != "" {
+		return junctions[row][0]
+	}
+	return edge
+}
+
+// isDividingLine returns true if `line` consists only of the symbols of dividing rows, including at least one filler.
+func isDividingLine(line string) bool {
+	if line == "" || !strings.Contains(line, borderFiller) && !strings.Contains(line, headerFiller) {
+		return false
+	}
+	symbols := borderEdge + borderLabelEdge + borderFiller + headerEdge + headerLabelEdge + headerFiller
+	for row := range junctions {
+		for _, symbol := range junctions[row] {
+			symbols += symbol
+		}
+	}
+	for _, r := range line {
+		if !strings.ContainsRune(symbols, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseColumns returns the [start, end) rune positions of the content of every column in the `border` line,
+// and the number of label levels, which are followed by a double edge.
+func parseColumns(border string) (columns [][2]int, numLabelLevels int) {
+	start := -1
+	for i, r := range []rune(border) {
+		if string(r) == borderFiller {
+			continue
+		}
+		// a junction directly after another marks a double label edge
+		if start == i-1 && len(columns) > 0 && numLabelLevels == 0 {
+			numLabelLevels = len(columns)
+		}
+		if start != -1 && i > start+1 {
+			columns = append(columns, [2]int{start + 1, i})
+		}
+		start = i
+	}
+	return columns, numLabelLevels
+}
+
+// parseCells returns the trimmed text in each of the `columns` of a rendered content `line`.
+func parseCells(line string, columns [][2]int) []string {
+	runes := []rune(line)
+	cells := make([]string, len(columns))
+	for k, c := range columns {
+		if c[0] >= len(runes) {
+			continue
+		}
+		end := c[1]
+		if end > len(runes) {
+			end = len(runes)
+		}
+		cells[k] = strings.TrimSpace(string(runes[c[0]:end]))
+	}
+	return cells
+}
@@ -0,0 +1,90 @@
+package tablewriter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              string
+		wantRows           [][]string
+		wantNumHeaderRows  int
+		wantDividers       []int
+		wantNumLabelLevels int
+	}{
+		{"header and body", "" +
+			"+------+-----+\n" +
+			"| name | val |\n" +
+			"|------|-----|\n" +
+			"|  a   |  1  |\n" +
+			"| b  c |     |\n" +
+			"+------+-----+\n",
+			[][]string{{"name", "val"}, {"a", "1"}, {"b  c", ""}}, 1, nil, 0},
+		{"no header", "" +
+			"+---+\n" +
+			"| a |\n" +
+			"+---+\n",
+			[][]string{{"a"}}, 0, nil, 0},
+		{"dividers, labels, and surrounding text", "" +
+			"title\n" +
+			"+---++-----+\r\n" +
+			"| a || x|y |\r\n" +
+			"+---++-----+\r\n" +
+			"| b || \x1b[1mz\x1b[0m   |\r\n" +
+			"+---++-----+\r\n" +
+			"trailer\n",
+			[][]string{{"a", "x|y"}, {"b", "z"}}, 0, []int{1}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := tbl.copyCells(0, tbl.rows.len()); !reflect.DeepEqual(got, tt.wantRows) {
+				t.Errorf("Parse() rows -> %v, want %v", got, tt.wantRows)
+			}
+			if tbl.numHeaderRows != tt.wantNumHeaderRows {
+				t.Errorf("Parse().numHeaderRows -> %v, want %v", tbl.numHeaderRows, tt.wantNumHeaderRows)
+			}
+			if !reflect.DeepEqual(tbl.dividers, tt.wantDividers) {
+				t.Errorf("Parse().dividers -> %v, want %v", tbl.dividers, tt.wantDividers)
+			}
+			if tbl.numLabelLevels != tt.wantNumLabelLevels {
+				t.Errorf("Parse().numLabelLevels -> %v, want %v", tbl.numLabelLevels, tt.wantNumLabelLevels)
+			}
+		})
+	}
+}
+
+func TestParse_roundTrip(t *testing.T) {
+	tbl := newTestTable([]string{"name", "count"}, [][]string{{"foo", "1"}, {"bar baz", "22"}})
+	tbl.AppendDivider()
+	tbl.AppendRow([]string{"total", "23"})
+	want, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	parsed, err := Parse(strings.NewReader(want))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got, err := parsed.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Parse().render() -> %v, want %v", got, want)
+	}
+}
+
+func TestParse_error(t *testing.T) {
+	for _, input := range []string{"", "foo\nbar\n", "+---+\n| a |\n"} {
+		if _, err := Parse(strings.NewReader(input)); err == nil {
+			t.Errorf("Parse(%q) returned nil error", input)
+		}
+	}
+}
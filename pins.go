@@ -0,0 +1,109 @@
+package tablewriter
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A Pin anchors a non-header row to the top or bottom of the table body, regardless of sorting or filtering.
+type Pin int
+
+const (
+	// PinNone leaves the row in its stored position.
+	PinNone Pin = iota
+	// PinTop renders the row before every unpinned row.
+	PinTop
+	// PinBottom renders the row after every unpinned row (e.g., an "UNKNOWN" bucket that always comes last).
+	PinBottom
+)
+
+// PinRow pins the non-header row at position `i` (0 is the first non-header row) according to `pin`.
+// Rows pinned to the same edge keep their relative order.
+func (tbl *Table) PinRow(i int, pin Pin) error {
+	n := tbl.numHeaderRows + i
+	if i < 0 || n >= tbl.rows.len() {
+		return fmt.Errorf("tbl.PinRow(): row %d out of range [0:%d]", i, tbl.rows.len()-tbl.numHeaderRows)
+	}
+	r := tbl.rows.at(n)
+	r.pin = pin
+	tbl.rows.set(n, r)
+	if pin != PinNone {
+		tbl.hasPins = true
+	}
+	return nil
+}
+
+// SortRows stably sorts the non-header rows using `less`. Pinned rows remain at the top or bottom of the table body.
+func (tbl *Table) SortRows(less func(a, b []string) bool) {
+	rows := tbl.bodyRecords()
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].pin != rows[j].pin {
+			return pinRank(rows[i].pin) < pinRank(rows[j].pin)
+		}
+		return less(rows[i].cells, rows[j].cells)
+	})
+	tbl.replaceBody(rows)
+}
+
+// FilterRows removes every unpinned non-header row for which `keep` returns false. Pinned rows are always kept.
+func (tbl *Table) FilterRows(keep func(row []string) bool) {
+	tbl.FilterTaggedRows(func(row []string, _ map[string]string) bool { return keep(row) })
+}
+
+// FilterTaggedRows removes every unpinned non-header row for which `keep` returns false,
+// given the row and the tags attached to it (nil if the row has no tags). Pinned rows are always kept.
+func (tbl *Table) FilterTaggedRows(keep func(row []string, tags map[string]string) bool) {
+	rows := tbl.bodyRecords()
+	kept := rows[:0]
+	for _, r := range rows {
+		if r.pin != PinNone || keep(r.cells, r.tags) {
+			kept = append(kept, r)
+		}
+	}
+	tbl.replaceBody(kept)
+}
+
+// bodyRecords returns a copy of the non-header records in the table, in stored order.
+func (tbl *Table) bodyRecords() []record {
+	ret := make([]record, 0, tbl.rows.len()-tbl.numHeaderRows)
+	for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+		ret = append(ret, tbl.rows.at(i))
+	}
+	return ret
+}
+
+// replaceBody replaces the non-header rows in the table with `rows`.
+func (tbl *Table) replaceBody(rows []record) {
+	tbl.rows.truncate(tbl.numHeaderRows)
+	for _, r := range rows {
+		tbl.rows.push(r)
+	}
+}
+
+// pinRank orders rows pinned to the top before unpinned rows, and unpinned rows before rows pinned to the bottom.
+func pinRank(pin Pin) int {
+	switch pin {
+	case PinTop:
+		return 0
+	case PinBottom:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// withPinnedRows returns a copy of `rows` with the non-header rows reordered so that pinned rows are at the top or bottom.
+func withPinnedRows(rows rowStore, numHeaderRows int) rowStore {
+	var ret rowStore
+	for i := 0; i < numHeaderRows && i < rows.len(); i++ {
+		ret.push(rows.at(i))
+	}
+	for rank := 0; rank <= 2; rank++ {
+		for i := numHeaderRows; i < rows.len(); i++ {
+			if r := rows.at(i); pinRank(r.pin) == rank {
+				ret.push(r)
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,59 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTable_PinRow(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       int
+		pin     Pin
+		want    [][]string
+		wantErr bool
+	}{
+		{"pin to bottom", 0, PinBottom, [][]string{{"name"}, {"bar"}, {"baz"}, {"UNKNOWN"}}, false},
+		{"pin to top", 2, PinTop, [][]string{{"name"}, {"baz"}, {"UNKNOWN"}, {"bar"}}, false},
+		{"unpin", 0, PinNone, [][]string{{"name"}, {"UNKNOWN"}, {"bar"}, {"baz"}}, false},
+		{"out of range", 3, PinTop, [][]string{{"name"}, {"UNKNOWN"}, {"bar"}, {"baz"}}, true},
+		{"negative", -1, PinTop, [][]string{{"name"}, {"UNKNOWN"}, {"bar"}, {"baz"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"name"}, [][]string{{"UNKNOWN"}, {"bar"}, {"baz"}})
+			if err := tbl.PinRow(tt.i, tt.pin); (err != nil) != tt.wantErr {
+				t.Fatalf("Table.PinRow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := tbl.view().rows.all(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Table.PinRow() rendered rows -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SortRows(t *testing.T) {
+	tbl := newTestTable([]string{"name"}, [][]string{{"total"}, {"UNKNOWN"}, {"c"}, {"a"}, {"b"}})
+	tbl.PinRow(0, PinTop)
+	tbl.PinRow(1, PinBottom)
+	tbl.SortRows(func(a, b []string) bool { return a[0] < b[0] })
+	want := [][]string{{"name"}, {"total"}, {"a"}, {"b"}, {"c"}, {"UNKNOWN"}}
+	if got := tbl.rows.all(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.SortRows().rows -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_FilterRows(t *testing.T) {
+	tbl := newTestTable([]string{"name"}, [][]string{{"UNKNOWN"}, {"bar"}, {"baz"}})
+	tbl.PinRow(0, PinBottom)
+	tbl.FilterRows(func(row []string) bool { return row[0] == "baz" })
+	want := [][]string{{"name"}, {"UNKNOWN"}, {"baz"}}
+	if got := tbl.rows.all(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.FilterRows().rows -> %v, want %v", got, want)
+	}
+	tbl.AppendRow([]string{"qux"})
+	want = [][]string{{"name"}, {"baz"}, {"qux"}, {"UNKNOWN"}}
+	if got := tbl.view().rows.all(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.FilterRows() rendered rows -> %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,30 @@
+package tablewriter
+
+import "bytes"
+
+// stringWriter is implemented by both *strings.Builder and *bytes.Buffer.
+type stringWriter interface {
+	Grow(n int)
+	WriteByte(c byte) error
+	WriteString(s string) (int, error)
+}
+
+// A renderer holds scratch space that is reused across rows and across renders,
+// so that re-rendering a long-lived table (e.g., once per second) does not allocate fresh buffers each time.
+// Because of this, a Table must not be rendered concurrently from multiple goroutines.
+type renderer struct {
+	// rowCopy holds a copy of the row currently being stringified, which is overwritten with wrapped remainders
+	rowCopy []string
+	// priorRow holds the most recent value in each column, for auto-merging
+	priorRow  []string
+	colWidths []int
+	paddings  []cellPadding
+	out       bytes.Buffer
+}
+
+// copyRow copies `cells` into the reusable row buffer and returns it.
+// The returned slice is only valid until the next call to copyRow.
+func (r *renderer) copyRow(cells []string) []string {
+	r.rowCopy = append(r.rowCopy[:0], cells...)
+	return r.rowCopy
+}
@@ -0,0 +1,236 @@
+package tablewriter
+
+import "strings"
+
+// rowChunkSize is the number of rows held by each chunk in a rowStore.
+const rowChunkSize = 1024
+
+// A record is a single row in a rowStore, along with any metadata used at render time.
+type record struct {
+	cells []string
+	// styles is either nil (no styling) or has the same length as cells
+	styles []Style
+	pin    Pin
+	// tags is either nil (no tags) or arbitrary metadata attached to the row by the caller
+	tags map[string]string
+	// values is either nil (the cells are fixed) or provides the cells at render time, with the same length as cells
+	values []CellValuer
+}
+
+// copyRecord returns a deep copy of `r`.
+func copyRecord(r record) record {
+	ret := record{cells: make([]string, len(r.cells)), pin: r.pin, tags: copyTags(r.tags), values: r.values}
+	copy(ret.cells, r.cells)
+	if r.styles != nil {
+		ret.styles = make([]Style, len(r.styles))
+		copy(ret.styles, r.styles)
+	}
+	return ret
+}
+
+// copyRows returns a new store containing the records in `rows`. The records themselves are not copied.
+func copyRows(rows rowStore) rowStore {
+	var ret rowStore
+	for i := 0; i < rows.len(); i++ {
+		ret.push(rows.at(i))
+	}
+	return ret
+}
+
+// A rowStore holds all the rows in a table (header rows first) in fixed-size chunks,
+// so that appending a row never requires copying the rows that were appended before it.
+// The zero value is an empty store ready to use.
+type rowStore struct {
+	chunks [][]record
+	n      int
+	arena  *cellArena
+}
+
+// newRowStore creates a store containing `rows`.
+func newRowStore(rows [][]string) rowStore {
+	var s rowStore
+	for i := range rows {
+		s.append(record{cells: rows[i]})
+	}
+	return s
+}
+
+// len returns the number of rows in the store.
+func (s *rowStore) len() int {
+	return s.n
+}
+
+// at returns the row at position `i`. Expects 0 <= i < s.len().
+func (s *rowStore) at(i int) record {
+	return s.chunks[i/rowChunkSize][i%rowChunkSize]
+}
+
+// set replaces the row at position `i`. Expects 0 <= i < s.len().
+func (s *rowStore) set(i int, r record) {
+	s.chunks[i/rowChunkSize][i%rowChunkSize] = r
+}
+
+// append adds `r` after the last row in the store.
+func (s *rowStore) append(r record) {
+	if s.arena != nil {
+		r.cells = s.arena.copyRow(r.cells)
+	}
+	s.push(r)
+}
+
+// push adds `r` after the last row in the store as-is.
+// Only the final chunk is ever grown, and every chunk except the final one is full.
+func (s *rowStore) push(r record) {
+	last := len(s.chunks) - 1
+	if last < 0 || len(s.chunks[last]) == rowChunkSize {
+		var chunk []record
+		// the first chunk grows on demand so that small tables stay small
+		if last >= 0 {
+			chunk = make([]record, 0, rowChunkSize)
+		}
+		s.chunks = append(s.chunks, chunk)
+		last++
+	}
+	s.chunks[last] = append(s.chunks[last], r)
+	s.n++
+}
+
+// grow reserves room for at least `n` more rows, so that the next `n` appends do not reallocate.
+func (s *rowStore) grow(n int) {
+	if n <= 0 {
+		return
+	}
+	numChunks := (s.n + n + rowChunkSize - 1) / rowChunkSize
+	if cap(s.chunks) < numChunks {
+		chunks := make([][]record, len(s.chunks), numChunks)
+		copy(chunks, s.chunks)
+		s.chunks = chunks
+	}
+	// only the first chunk grows on demand; every later chunk is allocated at full size
+	if len(s.chunks) == 0 {
+		s.chunks = append(s.chunks, nil)
+	}
+	if len(s.chunks) == 1 {
+		want := s.n + n
+		if want > rowChunkSize {
+			want = rowChunkSize
+		}
+		if cap(s.chunks[0]) < want {
+			chunk := make([]record, s.n, want)
+			copy(chunk, s.chunks[0])
+			s.chunks[0] = chunk
+		}
+	}
+	if s.arena != nil && s.n > 0 {
+		s.arena.reserve(n * len(s.at(0).cells))
+	}
+}
+
+// insert adds `r` at position `i`, shifting all subsequent rows down by one. Expects 0 <= i <= s.len().
+func (s *rowStore) insert(i int, r record) {
+	if i == s.n {
+		s.append(r)
+		return
+	}
+	if s.arena != nil {
+		r.cells = s.arena.copyRow(r.cells)
+	}
+	s.push(s.at(s.n - 1))
+	for k := s.n - 2; k > i; k-- {
+		s.set(k, s.at(k-1))
+	}
+	s.set(i, r)
+}
+
+// remove deletes the row at position `i`, shifting all subsequent rows up by one. Expects 0 <= i < s.len().
+func (s *rowStore) remove(i int) {
+	for k := i; k < s.n-1; k++ {
+		s.set(k, s.at(k+1))
+	}
+	s.truncate(s.n - 1)
+}
+
+// truncate removes every row after the first `n` rows, retaining the memory of the first chunk for reuse.
+// Expects 0 <= n <= s.len().
+func (s *rowStore) truncate(n int) {
+	for i := n; i < s.n; i++ {
+		// release references to the removed rows
+		s.set(i, record{})
+	}
+	numChunks := (n + rowChunkSize - 1) / rowChunkSize
+	if numChunks == 0 && len(s.chunks) > 0 {
+		numChunks = 1
+	}
+	s.chunks = s.chunks[:numChunks]
+	if numChunks > 0 {
+		last := numChunks - 1
+		s.chunks[last] = s.chunks[last][:n-last*rowChunkSize]
+	}
+	s.n = n
+}
+
+// all returns the cells in every row in the store as a single slice.
+// The cells themselves are not copied.
+func (s *rowStore) all() [][]string {
+	ret := make([][]string, 0, s.n)
+	for _, chunk := range s.chunks {
+		for _, r := range chunk {
+			ret = append(ret, r.cells)
+		}
+	}
+	return ret
+}
+
+// arenaBlockSize is the minimum number of bytes reserved by each new cellArena text block.
+const arenaBlockSize = 64 << 10
+
+// arenaCellBlockSize is the minimum number of cells reserved by each new cellArena cell block.
+const arenaCellBlockSize = 1024
+
+// A cellArena copies cells into large, append-only blocks of contiguous memory.
+// Each copied cell is a substring of a shared block, identified by its offset and length within that block,
+// so storing a cell costs no allocation of its own and retains no reference to the source string.
+type cellArena struct {
+	text  strings.Builder
+	cells []string
+}
+
+// copyString copies `s` into the current text block, starting a new block if there is not enough room.
+// Bytes already written to a block are never modified, so previously returned strings remain valid.
+func (a *cellArena) copyString(s string) string {
+	if a.text.Cap()-a.text.Len() < len(s) {
+		size := arenaBlockSize
+		if len(s) > size {
+			size = len(s)
+		}
+		a.text = strings.Builder{}
+		a.text.Grow(size)
+	}
+	start := a.text.Len()
+	a.text.WriteString(s)
+	return a.text.String()[start:]
+}
+
+// reserve ensures that the current cell block has room for at least `n` more cells.
+func (a *cellArena) reserve(n int) {
+	if cap(a.cells)-len(a.cells) < n {
+		a.cells = make([]string, 0, n)
+	}
+}
+
+// copyRow copies every cell in `row` into the arena and returns a row backed by the arena.
+func (a *cellArena) copyRow(row []string) []string {
+	if cap(a.cells)-len(a.cells) < len(row) {
+		size := arenaCellBlockSize
+		if len(row) > size {
+			size = len(row)
+		}
+		a.cells = make([]string, 0, size)
+	}
+	start := len(a.cells)
+	for _, cell := range row {
+		a.cells = append(a.cells, a.copyString(cell))
+	}
+	// cap the returned row so that appending to it can never overwrite a neighboring row
+	return a.cells[start:len(a.cells):len(a.cells)]
+}
@@ -0,0 +1,253 @@
+package tablewriter
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func makeTestRows(n int) [][]string {
+	ret := make([][]string, n)
+	for i := range ret {
+		ret[i] = []string{strconv.Itoa(i)}
+	}
+	return ret
+}
+
+func Test_rowStore_append(t *testing.T) {
+	tests := []struct {
+		name       string
+		rows       [][]string
+		wantChunks int
+	}{
+		{"empty", [][]string{}, 0},
+		{"single chunk", makeTestRows(3), 1},
+		{"full chunk", makeTestRows(rowChunkSize), 1},
+		{"multiple chunks", makeTestRows(2*rowChunkSize + 1), 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newRowStore(tt.rows)
+			if got := s.len(); got != len(tt.rows) {
+				t.Errorf("rowStore.len() = %v, want %v", got, len(tt.rows))
+			}
+			if got := len(s.chunks); got != tt.wantChunks {
+				t.Errorf("rowStore.chunks -> %v chunks, want %v", got, tt.wantChunks)
+			}
+			if got := s.all(); !reflect.DeepEqual(got, tt.rows) {
+				t.Errorf("rowStore.all() = %v, want %v", got, tt.rows)
+			}
+			for i := range tt.rows {
+				if got := s.at(i).cells; !reflect.DeepEqual(got, tt.rows[i]) {
+					t.Errorf("rowStore.at(%d) = %v, want %v", i, got, tt.rows[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_rowStore_insert(t *testing.T) {
+	type args struct {
+		i   int
+		row []string
+	}
+	tests := []struct {
+		name string
+		rows [][]string
+		args args
+	}{
+		{"empty", [][]string{}, args{0, []string{"foo"}}},
+		{"start", makeTestRows(3), args{0, []string{"foo"}}},
+		{"middle", makeTestRows(3), args{1, []string{"foo"}}},
+		{"end", makeTestRows(3), args{3, []string{"foo"}}},
+		{"across chunk boundary", makeTestRows(rowChunkSize + 2), args{1, []string{"foo"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newRowStore(tt.rows)
+			s.insert(tt.args.i, record{cells: tt.args.row})
+
+			want := append([][]string{}, tt.rows[:tt.args.i]...)
+			want = append(want, tt.args.row)
+			want = append(want, tt.rows[tt.args.i:]...)
+			if got := s.all(); !reflect.DeepEqual(got, want) {
+				t.Errorf("rowStore.insert() -> %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func Test_rowStore_truncate(t *testing.T) {
+	tests := []struct {
+		name       string
+		rows       [][]string
+		n          int
+		wantChunks int
+	}{
+		{"empty", [][]string{}, 0, 0},
+		{"all", makeTestRows(3), 0, 1},
+		{"some", makeTestRows(3), 1, 1},
+		{"none", makeTestRows(3), 3, 1},
+		{"across chunk boundary", makeTestRows(2*rowChunkSize + 1), rowChunkSize + 1, 2},
+		{"at chunk boundary", makeTestRows(2*rowChunkSize + 1), rowChunkSize, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newRowStore(tt.rows)
+			s.truncate(tt.n)
+			if got := s.all(); !reflect.DeepEqual(got, tt.rows[:tt.n]) {
+				t.Errorf("rowStore.truncate() -> %v, want %v", got, tt.rows[:tt.n])
+			}
+			if got := len(s.chunks); got != tt.wantChunks {
+				t.Errorf("rowStore.truncate() -> %v chunks, want %v", got, tt.wantChunks)
+			}
+			// appending after truncating picks up where the store left off
+			s.append(record{cells: []string{"foo"}})
+			if got := s.at(tt.n).cells; !reflect.DeepEqual(got, []string{"foo"}) {
+				t.Errorf("rowStore.at(%d) after truncate and append = %v, want %v", tt.n, got, []string{"foo"})
+			}
+		})
+	}
+}
+
+func Test_rowStore_remove(t *testing.T) {
+	rows := makeTestRows(rowChunkSize + 2)
+	s := newRowStore(rows)
+	s.remove(1)
+	want := append(append([][]string(nil), rows[:1]...), rows[2:]...)
+	if got := s.all(); !reflect.DeepEqual(got, want) {
+		t.Errorf("rowStore.remove() -> %d rows, want %d", len(got), len(want))
+	}
+	if got := s.len(); got != rowChunkSize+1 {
+		t.Errorf("rowStore.remove().len() = %v, want %v", got, rowChunkSize+1)
+	}
+}
+
+func Test_cellArena_copyRow(t *testing.T) {
+	tests := []struct {
+		name string
+		rows [][]string
+	}{
+		{"single row", [][]string{{"foo", "bar"}}},
+		{"multiple rows", [][]string{{"foo", "bar"}, {"", "baz"}}},
+		{"cell larger than block", [][]string{{string(make([]byte, arenaBlockSize+1))}, {"foo"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &cellArena{}
+			var got [][]string
+			for _, row := range tt.rows {
+				got = append(got, a.copyRow(row))
+			}
+			if !reflect.DeepEqual(got, tt.rows) {
+				t.Errorf("cellArena.copyRow() = %v, want %v", got, tt.rows)
+			}
+			// appending to a copied row must not overwrite its neighbor
+			_ = append(got[0], "corge")
+			if len(got) > 1 && !reflect.DeepEqual(got[1], tt.rows[1]) {
+				t.Errorf("cellArena.copyRow() -> %v after append to prior row, want %v", got[1], tt.rows[1])
+			}
+		})
+	}
+}
+
+func TestTable_EnableArenaStorage(t *testing.T) {
+	tbl := NewTable(nil)
+	tbl.EnableArenaStorage()
+	if tbl.rows.arena == nil {
+		t.Fatalf("Table.EnableArenaStorage().rows.arena -> nil, want non-nil")
+	}
+	src := []string{"foo", "bar"}
+	tbl.AppendRow(src)
+	tbl.AppendHeaderRow([]string{"baz", "qux"})
+	src[0] = "corge"
+	want := [][]string{{"baz", "qux"}, {"foo", "bar"}}
+	if got := tbl.rows.all(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.EnableArenaStorage() -> rows %v, want %v", got, want)
+	}
+}
+
+// simulates a source (such as csv.Reader with ReuseRecord) that reuses its row buffer,
+// so without arena storage every row must be copied before it is appended
+func benchmarkAppendRows(b *testing.B, arena bool) {
+	buf := make([]byte, 0, 64)
+	row := make([]string, 4)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		tbl := NewTable(nil)
+		if arena {
+			tbl.EnableArenaStorage()
+		}
+		for i := 0; i < 10000; i++ {
+			buf = strconv.AppendInt(buf[:0], int64(i), 10)
+			for k := range row {
+				row[k] = string(buf)
+			}
+			if arena {
+				tbl.AppendRow(row)
+				continue
+			}
+			rowCopy := make([]string, len(row))
+			copy(rowCopy, row)
+			tbl.AppendRow(rowCopy)
+		}
+	}
+}
+
+func BenchmarkAppendRows(b *testing.B)      { benchmarkAppendRows(b, false) }
+func BenchmarkAppendRowsArena(b *testing.B) { benchmarkAppendRows(b, true) }
+
+func Test_rowStore_grow(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   int
+		n          int
+		wantCap    int
+		wantChunks int
+	}{
+		{"empty", 0, 10, 10, 1},
+		{"existing rows", 5, 10, 15, 1},
+		{"capped at chunk size", 0, rowChunkSize*2 + 1, rowChunkSize, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newRowStore(makeTestRows(tt.existing))
+			s.grow(tt.n)
+			if got := cap(s.chunks[0]); got != tt.wantCap {
+				t.Errorf("rowStore.grow() first chunk capacity -> %v, want %v", got, tt.wantCap)
+			}
+			if got := cap(s.chunks); got < tt.wantChunks {
+				t.Errorf("rowStore.grow() chunk capacity -> %v, want at least %v", got, tt.wantChunks)
+			}
+			for i := 0; i < tt.n; i++ {
+				s.append(record{cells: []string{"foo"}})
+			}
+			if got, want := s.len(), tt.existing+tt.n; got != want {
+				t.Errorf("rowStore.len() -> %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestNewTableWithCapacity(t *testing.T) {
+	tbl := NewTableWithCapacity(nil, 100, 1)
+	tbl.AppendHeaderRow([]string{"foo"})
+	if err := tbl.AppendRows(makeTestRows(99)); err != nil {
+		t.Fatalf("Table.AppendRows() error = %v", err)
+	}
+	if got := cap(tbl.rows.chunks[0]); got != 100 {
+		t.Errorf("NewTableWithCapacity() first chunk capacity -> %v, want 100", got)
+	}
+	if _, err := tbl.render(); err != nil {
+		t.Errorf("Table.render() error = %v", err)
+	}
+}
+
+func BenchmarkAppendRows_grow(b *testing.B) {
+	rows := makeTestRows(10000)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		tbl := NewTableWithCapacity(nil, len(rows), 1)
+		tbl.AppendRows(rows)
+	}
+}
@@ -0,0 +1,76 @@
+package tablewriter
+
+import "fmt"
+
+// A ShapePolicy configures how rows whose number of cells differs from the number of columns in the table are handled.
+type ShapePolicy int
+
+const (
+	// ShapeStrict rejects the row with an error.
+	ShapeStrict ShapePolicy = iota
+	// ShapePad pads short rows with empty cells and rejects long rows with an error.
+	ShapePad
+	// ShapePadTruncate pads short rows with empty cells and drops the extra cells from long rows.
+	ShapePadTruncate
+)
+
+// SetShapePolicy sets how AppendRow, AppendHeaderRow, AppendRowWithTags, InsertRow, InsertHeaderRow, and SetRow handle rows
+// whose number of cells differs from the number of columns in the table, so that messy input (e.g., ragged CSV records)
+// can be rendered without cleaning it first. Styled rows, lazy rows, and header subtext must always match. (Default: ShapeStrict).
+func (tbl *Table) SetShapePolicy(policy ShapePolicy) {
+	tbl.shapePolicy = policy
+}
+
+// AppendSparseRow is like AppendRow, but accepts a row with fewer cells than the table has columns (e.g., a ragged record from a CSV file),
+// and pads it with empty cells. Rows with too many cells are an error unless the shape policy is ShapePadTruncate.
+// Any shape observer is notified of each reshaped row.
+func (tbl *Table) AppendSparseRow(row []string) error {
+	policy := tbl.shapePolicy
+	if policy < ShapePad {
+		policy = ShapePad
+	}
+	reshaped, err := tbl.reshapeRow(row, tbl.NumRows(), policy)
+	if err != nil {
+		return fmt.Errorf("tbl.AppendSparseRow(): appending row (%v): %v", row, err)
+	}
+	tbl.rows.append(record{cells: reshaped})
+	return nil
+}
+
+// SetShapeObserver registers a callback that is notified whenever a row whose length differs from the table width
+// is accepted and reshaped instead of rejected (e.g., to log a warning about messy input).
+// The callback receives the position of the reshaped non-header row (starting at 0, or -1 for a header row),
+// the number of cells supplied, and the number of columns. A nil observer removes any existing observer.
+func (tbl *Table) SetShapeObserver(observer func(row, numCells, numCols int)) {
+	tbl.shapeObserver = observer
+}
+
+// reshape returns `row` reshaped to the width of the table according to the table's shape policy.
+// `pos` is the position of the row among the non-header rows, or -1 for a header row.
+func (tbl *Table) reshape(row []string, pos int) ([]string, error) {
+	return tbl.reshapeRow(row, pos, tbl.shapePolicy)
+}
+
+// reshapeRow returns `row` padded with empty cells or truncated to the width of the table as allowed by `policy`,
+// notifying any shape observer, or an error if `policy` does not allow the change.
+// A row that already has the right width, or any row in an empty table, is returned as-is.
+func (tbl *Table) reshapeRow(row []string, pos int, policy ShapePolicy) ([]string, error) {
+	if tbl.rows.len() == 0 {
+		return row, nil
+	}
+	numCols := len(tbl.rows.at(0).cells)
+	switch {
+	case len(row) == numCols:
+		return row, nil
+	case policy == ShapeStrict:
+		return nil, tbl.sameShape(row)
+	case len(row) > numCols && policy != ShapePadTruncate:
+		return nil, fmt.Errorf("new row must not have more fields than existing rows in Table (%d > %d)", len(row), numCols)
+	}
+	if tbl.shapeObserver != nil {
+		tbl.shapeObserver(pos, len(row), numCols)
+	}
+	reshaped := make([]string, numCols)
+	copy(reshaped, row)
+	return reshaped, nil
+}
@@ -0,0 +1,73 @@
+package tablewriter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTable_AppendSparseRow(t *testing.T) {
+	tbl := newTestTable([]string{"a", "b", "c"}, [][]string{{"1", "2", "3"}})
+	var observed [][3]int
+	tbl.SetShapeObserver(func(row, numCells, numCols int) {
+		observed = append(observed, [3]int{row, numCells, numCols})
+	})
+	if err := tbl.AppendSparseRow([]string{"4"}); err != nil {
+		t.Fatalf("Table.AppendSparseRow() error = %v", err)
+	}
+	if err := tbl.AppendSparseRow([]string{"5", "6", "7"}); err != nil {
+		t.Fatalf("Table.AppendSparseRow() error = %v", err)
+	}
+	if err := tbl.AppendSparseRow([]string{"8", "9", "10", "11"}); err == nil {
+		t.Errorf("Table.AppendSparseRow() with too many fields: error = nil, want error")
+	}
+	want := [][]string{{"1", "2", "3"}, {"4", "", ""}, {"5", "6", "7"}}
+	if got := tbl.Rows(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.AppendSparseRow() -> %v, want %v", got, want)
+	}
+	if want := [][3]int{{1, 1, 3}}; !reflect.DeepEqual(observed, want) {
+		t.Errorf("Table.SetShapeObserver() observed %v, want %v", observed, want)
+	}
+}
+
+func TestTable_SetShapePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  ShapePolicy
+		want    [][]string
+		wantErr []bool
+	}{
+		{"strict", ShapeStrict, [][]string{{"a", "b"}, {"5", "6"}}, []bool{true, true, false}},
+		{"pad", ShapePad, [][]string{{"a", "b"}, {"1", ""}, {"5", "6"}}, []bool{false, true, false}},
+		{"pad and truncate", ShapePadTruncate, [][]string{{"a", "b"}, {"1", ""}, {"2", "3"}, {"5", "6"}}, []bool{false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"h1", "h2"}, [][]string{{"a", "b"}})
+			tbl.SetShapePolicy(tt.policy)
+			for n, row := range [][]string{{"1"}, {"2", "3", "4"}, {"5", "6"}} {
+				if err := tbl.AppendRow(row); (err != nil) != tt.wantErr[n] {
+					t.Errorf("Table.AppendRow(%v) error = %v, want error %v", row, err, tt.wantErr[n])
+				}
+			}
+			if got := tbl.Rows(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Table.SetShapePolicy() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_AppendRow_errorReportsRow(t *testing.T) {
+	tbl := newTestTable([]string{"a", "b"}, nil)
+	for _, policy := range []ShapePolicy{ShapeStrict, ShapePad} {
+		tbl.SetShapePolicy(policy)
+		err := tbl.AppendRow([]string{"x", "y", "z"})
+		if err == nil || !strings.Contains(err.Error(), "[x y z]") {
+			t.Errorf("Table.AppendRow() error = %v, want error reporting the row", err)
+		}
+		err = tbl.AppendRowWithTags([]string{"x", "y", "z"}, nil)
+		if err == nil || !strings.Contains(err.Error(), "[x y z]") {
+			t.Errorf("Table.AppendRowWithTags() error = %v, want error reporting the row", err)
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package tablewriter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// A TableSpec is a serializable definition of a table: its rows and the settings that affect how it is rendered,
+// so that a table can be stored or sent over RPC and re-rendered elsewhere.
+// The output is identical unless the table uses a setting that is not included: settings that hold functions
+// (e.g., computed columns, custom formatters, row stylers, and width observers), locales, highlights, percentages of totals,
+// header subtext, metadata, and trailers.
+// The zero value of every field is the default setting.
+type TableSpec struct {
+	Headers [][]string `json:"headers,omitempty"`
+	Rows    [][]string `json:"rows,omitempty"`
+	// Styles holds the style of every cell in Rows, or is nil if no cell is styled. Unstyled rows are nil.
+	Styles [][]Style `json:"styles,omitempty"`
+	// Links holds the hyperlink of every cell in Rows, or is nil if no cell is linked. Rows without links are nil.
+	Links [][]string `json:"links,omitempty"`
+	// Pins holds the pin of every row in Rows, or is nil if no row is pinned.
+	Pins []Pin `json:"pins,omitempty"`
+	// Tags holds the tags of every row in Rows, or is nil if no row has tags. Rows without tags are nil.
+	Tags     []map[string]string `json:"tags,omitempty"`
+	Dividers []int               `json:"dividers,omitempty"`
+
+	Alignment                 Alignment          `json:"alignment,omitempty"`
+	SmartAlignment            bool               `json:"smartAlignment,omitempty"`
+	NumLabelLevels            int                `json:"numLabelLevels,omitempty"`
+	LabelSide                 Side               `json:"labelSide,omitempty"`
+	MergeRepeats              bool               `json:"mergeRepeats,omitempty"`
+	MergePosition             MergePosition      `json:"mergePosition,omitempty"`
+	MergeScope                MergeScope         `json:"mergeScope,omitempty"`
+	MergeCounts               bool               `json:"mergeCounts,omitempty"`
+	Placeholder               string             `json:"placeholder,omitempty"`
+	PlaceholderMerged         bool               `json:"placeholderMerged,omitempty"`
+	TruncateWideCells         bool               `json:"truncateWideCells,omitempty"`
+	MaxColWidth               int                `json:"maxColWidth,omitempty"`
+	MinColWidth               int                `json:"minColWidth,omitempty"`
+	TotalWidth                int                `json:"totalWidth,omitempty"`
+	ColumnWidths              []int              `json:"columnWidths,omitempty"`
+	Padding                   *[2]int            `json:"padding,omitempty"`
+	NoHeaderAutoCentering     bool               `json:"noHeaderAutoCentering,omitempty"`
+	HeaderOverflow            Overflow           `json:"headerOverflow,omitempty"`
+	HeaderStyle               Style              `json:"headerStyle,omitempty"`
+	ZebraStripes              *[2]Style          `json:"zebraStripes,omitempty"`
+	AutoIndex                 bool               `json:"autoIndex,omitempty"`
+	MaxRows                   int                `json:"maxRows,omitempty"`
+	MaxLinesPerCell           int                `json:"maxLinesPerCell,omitempty"`
+	WrapContinuation          string             `json:"wrapContinuation,omitempty"`
+	WrapContinuationAlignment *Alignment         `json:"wrapContinuationAlignment,omitempty"`
+	StrictWidths              bool               `json:"strictWidths,omitempty"`
+	HeaderDivider             *[2]string         `json:"headerDivider,omitempty"`
+	IndexOffset               int                `json:"indexOffset,omitempty"`
+	CRLF                      bool               `json:"crlf,omitempty"`
+	TabWidth                  int                `json:"tabWidth,omitempty"`
+	StripControls             bool               `json:"stripControls,omitempty"`
+	EscapeControls            bool               `json:"escapeControls,omitempty"`
+	EscapeSeparators          bool               `json:"escapeSeparators,omitempty"`
+	SeparatorReplacement      string             `json:"separatorReplacement,omitempty"`
+	DisableHyperlinks         bool               `json:"disableHyperlinks,omitempty"`
+	TrimTrailingWhitespace    bool               `json:"trimTrailingWhitespace,omitempty"`
+	Suppress                  Suppression        `json:"suppress,omitempty"`
+	ColumnSpacing             int                `json:"columnSpacing,omitempty"`
+	ClipIndicator             ClipIndicator      `json:"clipIndicator,omitempty"`
+	ShapePolicy               ShapePolicy        `json:"shapePolicy,omitempty"`
+	GroupBy                   *GroupSpec         `json:"groupBy,omitempty"`
+	Footer                    map[int]Aggregator `json:"footer,omitempty"`
+	Columns                   map[int]ColumnSpec `json:"columns,omitempty"`
+}
+
+// A GroupSpec is the serializable form of the settings configured by GroupBy.
+type GroupSpec struct {
+	Column      int                `json:"column"`
+	Aggregators map[int]Aggregator `json:"aggregators,omitempty"`
+}
+
+// A ColumnSpec is the serializable form of the settings of a single column.
+// Formatting is restored from Kind and Type, so custom formatters are not included.
+type ColumnSpec struct {
+	Kind      ColumnKind     `json:"kind,omitempty"`
+	Type      ColumnType     `json:"type,omitempty"`
+	Alignment *Alignment     `json:"alignment,omitempty"`
+	Overflow  Overflow       `json:"overflow,omitempty"`
+	Padding   *[2]int        `json:"padding,omitempty"`
+	MinWidth  int            `json:"minWidth,omitempty"`
+	Priority  ShrinkPriority `json:"priority,omitempty"`
+}
+
+// Spec returns a serializable definition of the table. Every slice and map is a copy.
+func (tbl *Table) Spec() TableSpec {
+	spec := TableSpec{
+		Headers:                tbl.HeaderRows(),
+		Rows:                   tbl.Rows(),
+		Dividers:               append([]int(nil), tbl.dividers...),
+		Alignment:              tbl.alignment,
+		SmartAlignment:         tbl.smartAlignment,
+		NumLabelLevels:         tbl.numLabelLevels,
+		LabelSide:              tbl.labelSide,
+		MergeRepeats:           tbl.autoMerge,
+		MergePosition:          tbl.mergePosition,
+		MergeScope:             tbl.mergeScope,
+		MergeCounts:            tbl.mergeCounts,
+		Placeholder:            tbl.placeholder,
+		PlaceholderMerged:      tbl.placeholderMerged,
+		TruncateWideCells:      tbl.truncateCells,
+		MaxColWidth:            tbl.maxWidth,
+		MinColWidth:            tbl.minWidth,
+		TotalWidth:             tbl.totalWidth,
+		ColumnWidths:           append([]int(nil), tbl.fixedWidths...),
+		NoHeaderAutoCentering:  !tbl.autoCenterHeaders,
+		HeaderOverflow:         tbl.headerOverflow,
+		HeaderStyle:            tbl.headerStyle,
+		AutoIndex:              tbl.autoIndex,
+		MaxRows:                tbl.maxRows,
+		MaxLinesPerCell:        tbl.maxLinesPerCell,
+		WrapContinuation:       tbl.continuationMarker,
+		StrictWidths:           tbl.strictWidths,
+		IndexOffset:            tbl.indexOffset,
+		CRLF:                   tbl.crlf,
+		TabWidth:               tbl.tabWidth,
+		StripControls:          tbl.stripControls,
+		EscapeControls:         tbl.escapeControls,
+		EscapeSeparators:       tbl.escapeSeparators,
+		SeparatorReplacement:   tbl.separatorReplacement,
+		DisableHyperlinks:      tbl.plainLinks,
+		TrimTrailingWhitespace: tbl.trimTrailingSpace,
+		Suppress:               tbl.suppress,
+		ColumnSpacing:          tbl.spacing,
+		ClipIndicator:          tbl.clipIndicator,
+		ShapePolicy:            tbl.shapePolicy,
+	}
+	if len(spec.Headers) == 0 {
+		spec.Headers = nil
+	}
+	if len(spec.Rows) == 0 {
+		spec.Rows = nil
+	}
+	if len(spec.Dividers) == 0 {
+		spec.Dividers = nil
+	}
+	if tbl.padding != nil {
+		spec.Padding = &[2]int{tbl.padding.left, tbl.padding.right}
+	}
+	if tbl.zebraStripes != nil {
+		stripes := *tbl.zebraStripes
+		spec.ZebraStripes = &stripes
+	}
+	if tbl.hasContinuationAlignment {
+		alignment := tbl.continuationAlignment
+		spec.WrapContinuationAlignment = &alignment
+	}
+	if tbl.headerDivider != nil {
+		spec.HeaderDivider = &[2]string{tbl.headerDivider.filler, tbl.headerDivider.edge}
+	}
+	for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+		r := tbl.rows.at(i)
+		if r.pin != PinNone {
+			if spec.Pins == nil {
+				spec.Pins = make([]Pin, tbl.NumRows())
+			}
+			spec.Pins[i-tbl.numHeaderRows] = r.pin
+		}
+		if r.tags != nil {
+			if spec.Tags == nil {
+				spec.Tags = make([]map[string]string, tbl.NumRows())
+			}
+			spec.Tags[i-tbl.numHeaderRows] = copyTags(r.tags)
+		}
+	}
+	for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+		styles := tbl.rows.at(i).styles
+		if styles == nil {
+			continue
+		}
+		if spec.Styles == nil {
+			spec.Styles = make([][]Style, tbl.NumRows())
+			spec.Links = make([][]string, tbl.NumRows())
+		}
+		row := make([]Style, len(styles))
+		links := make([]string, len(styles))
+		var linked bool
+		for k := range styles {
+			row[k], links[k] = styles[k], styles[k].link
+			row[k].link = ""
+			linked = linked || links[k] != ""
+		}
+		spec.Styles[i-tbl.numHeaderRows] = row
+		if linked {
+			spec.Links[i-tbl.numHeaderRows] = links
+		}
+	}
+	if spec.Links != nil && allNil(spec.Links) {
+		spec.Links = nil
+	}
+	if tbl.groupBy != nil {
+		spec.GroupBy = &GroupSpec{Column: tbl.groupBy.col, Aggregators: make(map[int]Aggregator, len(tbl.groupBy.aggregators))}
+		for k, agg := range tbl.groupBy.aggregators {
+			spec.GroupBy.Aggregators[k] = agg
+		}
+	}
+	for k, agg := range tbl.footer {
+		if spec.Footer == nil {
+			spec.Footer = make(map[int]Aggregator, len(tbl.footer))
+		}
+		spec.Footer[k] = agg
+	}
+	for k, c := range tbl.columns {
+		col := ColumnSpec{Kind: c.kind, Type: c.ctype, Overflow: c.overflow, MinWidth: c.minWidth, Priority: c.priority}
+		if c.hasAlignment {
+			alignment := c.alignment
+			col.Alignment = &alignment
+		}
+		if c.padding != nil {
+			col.Padding = &[2]int{c.padding.left, c.padding.right}
+		}
+		if col == (ColumnSpec{}) {
+			continue
+		}
+		if spec.Columns == nil {
+			spec.Columns = make(map[int]ColumnSpec, len(tbl.columns))
+		}
+		spec.Columns[k] = col
+	}
+	return spec
+}
+
+// allNil returns true if every row in `rows` is nil.
+func allNil(rows [][]string) bool {
+	for _, row := range rows {
+		if row != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// NewTableFromSpec creates a table writing to `w` from the definition in `spec`.
+// Returns an error if the rows do not all have the same number of cells, or if any styles, links, or column widths
+// do not match the rows.
+func NewTableFromSpec(w io.Writer, spec TableSpec) (*Table, error) {
+	tbl := NewTable(w)
+	for _, row := range spec.Headers {
+		if err := tbl.AppendHeaderRow(row); err != nil {
+			return nil, fmt.Errorf("NewTableFromSpec(): %v", err)
+		}
+	}
+	if spec.Styles != nil && len(spec.Styles) != len(spec.Rows) {
+		return nil, fmt.Errorf("NewTableFromSpec(): must have one row of styles per row (%d != %d)", len(spec.Styles), len(spec.Rows))
+	}
+	if spec.Links != nil && len(spec.Links) != len(spec.Rows) {
+		return nil, fmt.Errorf("NewTableFromSpec(): must have one row of links per row (%d != %d)", len(spec.Links), len(spec.Rows))
+	}
+	if spec.Pins != nil && len(spec.Pins) != len(spec.Rows) {
+		return nil, fmt.Errorf("NewTableFromSpec(): must have one pin per row (%d != %d)", len(spec.Pins), len(spec.Rows))
+	}
+	if spec.Tags != nil && len(spec.Tags) != len(spec.Rows) {
+		return nil, fmt.Errorf("NewTableFromSpec(): must have one set of tags per row (%d != %d)", len(spec.Tags), len(spec.Rows))
+	}
+	for i, row := range spec.Rows {
+		var styles []Style
+		var links []string
+		if spec.Styles != nil {
+			styles = spec.Styles[i]
+		}
+		if spec.Links != nil {
+			links = spec.Links[i]
+		}
+		if styles == nil && links == nil {
+			if err := tbl.AppendRow(row); err != nil {
+				return nil, fmt.Errorf("NewTableFromSpec(): %v", err)
+			}
+			continue
+		}
+		if styles != nil && len(styles) != len(row) || links != nil && len(links) != len(row) {
+			return nil, fmt.Errorf("NewTableFromSpec(): row %d: must have one style and link per cell", i)
+		}
+		cells := make([]Cell, len(row))
+		for k := range row {
+			cells[k].Text = row[k]
+			if styles != nil {
+				cells[k].Style = styles[k]
+			}
+			if links != nil {
+				cells[k].Link = links[k]
+			}
+		}
+		if err := tbl.AppendStyledRow(cells); err != nil {
+			return nil, fmt.Errorf("NewTableFromSpec(): %v", err)
+		}
+	}
+	for i, pin := range spec.Pins {
+		if pin != PinNone {
+			tbl.PinRow(i, pin)
+		}
+	}
+	for i, tags := range spec.Tags {
+		if tags != nil {
+			n := tbl.numHeaderRows + i
+			r := tbl.rows.at(n)
+			r.tags = copyTags(tags)
+			tbl.rows.set(n, r)
+		}
+	}
+	tbl.dividers = append([]int(nil), spec.Dividers...)
+	tbl.SetAlignment(spec.Alignment)
+	if spec.SmartAlignment {
+		tbl.EnableSmartAlignment()
+	}
+	tbl.SetLabelLevelCount(spec.NumLabelLevels)
+	tbl.SetLabelSide(spec.LabelSide)
+	if spec.MergeRepeats {
+		tbl.MergeRepeats()
+	}
+	tbl.SetMergePosition(spec.MergePosition)
+	tbl.SetMergeScope(spec.MergeScope)
+	if spec.MergeCounts {
+		tbl.AnnotateMergeCounts()
+	}
+	tbl.SetEmptyCellPlaceholder(spec.Placeholder, spec.PlaceholderMerged)
+	if spec.TruncateWideCells {
+		tbl.TruncateWideCells()
+	}
+	tbl.SetMaxColumnWidth(spec.MaxColWidth)
+	tbl.SetDefaultMinColumnWidth(spec.MinColWidth)
+	tbl.SetTotalWidth(spec.TotalWidth)
+	if spec.Padding != nil {
+		tbl.SetPadding(spec.Padding[0], spec.Padding[1])
+	}
+	if spec.NoHeaderAutoCentering {
+		tbl.DisableHeaderAutoCentering()
+	}
+	tbl.SetHeaderOverflow(spec.HeaderOverflow)
+	tbl.SetHeaderStyle(spec.HeaderStyle)
+	if spec.ZebraStripes != nil {
+		tbl.EnableZebraStripes(spec.ZebraStripes[0], spec.ZebraStripes[1])
+	}
+	if spec.AutoIndex {
+		tbl.EnableAutoIndex()
+	}
+	tbl.SetMaxRows(spec.MaxRows)
+	tbl.SetMaxLinesPerCell(spec.MaxLinesPerCell)
+	tbl.SetWrapContinuation(spec.WrapContinuation)
+	if spec.WrapContinuationAlignment != nil {
+		tbl.SetWrapContinuationAlignment(*spec.WrapContinuationAlignment)
+	}
+	if spec.StrictWidths {
+		tbl.EnableStrictWidths()
+	}
+	if spec.HeaderDivider != nil {
+		if err := tbl.SetHeaderDivider(spec.HeaderDivider[0], spec.HeaderDivider[1]); err != nil {
+			return nil, fmt.Errorf("NewTableFromSpec(): %v", err)
+		}
+	}
+	tbl.indexOffset = spec.IndexOffset
+	tbl.crlf = spec.CRLF
+	tbl.SetTabWidth(spec.TabWidth)
+	if spec.StripControls {
+		tbl.StripControlCharacters(spec.EscapeControls)
+	}
+	if spec.EscapeSeparators {
+		tbl.EscapeSeparators(spec.SeparatorReplacement)
+	}
+	if spec.DisableHyperlinks {
+		tbl.DisableHyperlinks()
+	}
+	if spec.TrimTrailingWhitespace {
+		tbl.TrimTrailingWhitespace()
+	}
+	tbl.Suppress(spec.Suppress)
+	tbl.SetColumnSpacing(spec.ColumnSpacing)
+	tbl.SetClipIndicator(spec.ClipIndicator)
+	tbl.SetShapePolicy(spec.ShapePolicy)
+	if spec.GroupBy != nil {
+		tbl.GroupBy(spec.GroupBy.Column, spec.GroupBy.Aggregators)
+	}
+	for k, agg := range spec.Footer {
+		tbl.AddFooterAggregate(k, agg)
+	}
+	for k, col := range spec.Columns {
+		tbl.SetColumnKind(k, col.Kind)
+		if col.Type != TypeString {
+			tbl.SetColumnType(k, col.Type)
+		}
+		if col.Alignment != nil {
+			tbl.SetColumnAlignment(k, *col.Alignment)
+		}
+		if col.Overflow != OverflowDefault {
+			tbl.SetColumnOverflow(k, col.Overflow)
+		}
+		if col.Padding != nil {
+			tbl.SetColumnPadding(k, col.Padding[0], col.Padding[1])
+		}
+		if col.MinWidth != 0 {
+			tbl.SetMinColumnWidth(k, col.MinWidth)
+		}
+		tbl.SetColumnPriority(k, col.Priority)
+	}
+	if spec.ColumnWidths != nil {
+		if err := tbl.SetColumnWidths(spec.ColumnWidths); err != nil {
+			return nil, fmt.Errorf("NewTableFromSpec(): %v", err)
+		}
+	}
+	return tbl, nil
+}
+
+// MarshalJSON encodes the table's definition (see TableSpec).
+func (tbl *Table) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tbl.Spec())
+}
+
+// UnmarshalJSON replaces the table with the definition encoded in `data` (see TableSpec), keeping its io.Writer.
+func (tbl *Table) UnmarshalJSON(data []byte) error {
+	var spec TableSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return fmt.Errorf("tbl.UnmarshalJSON(): %v", err)
+	}
+	decoded, err := NewTableFromSpec(tbl.w, spec)
+	if err != nil {
+		return fmt.Errorf("tbl.UnmarshalJSON(): %v", err)
+	}
+	*tbl = *decoded
+	return nil
+}
@@ -0,0 +1,134 @@
+package tablewriter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func newSpecTestTable() *Table {
+	tbl := newTestTable([]string{"group", "name", "n"}, [][]string{
+		{"a", "foo", "1234"},
+		{"a", "barbaz", "5"},
+	})
+	tbl.AppendDivider()
+	tbl.AppendStyledRow([]Cell{{Text: "b"}, {Text: "qux", Style: Style{Bold: true}, Link: "https://example.com"}, {Text: "6"}})
+	tbl.SetLabelLevelCount(1)
+	tbl.MergeRepeats()
+	tbl.SetEmptyCellPlaceholder("-", false)
+	tbl.SetPadding(2, 1)
+	tbl.SetHeaderStyle(Style{Underline: true})
+	tbl.EnableAutoIndex()
+	tbl.SetColumnKind(2, KindCount)
+	tbl.SetColumnAlignment(1, AlignLeft)
+	tbl.SetMinColumnWidth(1, 8)
+	tbl.AddFooterAggregate(2, AggSum)
+	return tbl
+}
+
+func TestTable_MarshalJSON(t *testing.T) {
+	tbl := newSpecTestTable()
+	want, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	b, err := json.Marshal(tbl)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	decoded := NewTable(nil)
+	if err := json.Unmarshal(b, decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	got, err := decoded.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("decoded Table.render() -> %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(decoded.Spec(), tbl.Spec()) {
+		t.Errorf("decoded Table.Spec() -> %+v, want %+v", decoded.Spec(), tbl.Spec())
+	}
+}
+
+func TestTable_MarshalJSON_renderSettings(t *testing.T) {
+	tbl := newTestTable([]string{"name", "n"}, [][]string{{"unknown", "1"}, {"foo\tbar|baz", "22"}, {"qux", "333"}})
+	tbl.PinRow(0, PinBottom)
+	tbl.SetLineEnding(LineEndingCRLF)
+	tbl.EnableSmartAlignment()
+	tbl.SetTabWidth(4)
+	tbl.EscapeSeparators("¦")
+	tbl.SetWrapContinuation("> ")
+	tbl.SetWrapContinuationAlignment(AlignLeft)
+	tbl.SetHeaderDivider("=", "")
+	tbl.DisableHyperlinks()
+	tbl.StripControlCharacters(true)
+	tbl.AppendRowWithTags([]string{"tagged", "4"}, map[string]string{"status": "ok"})
+	want, err := tbl.renderBytes()
+	if err != nil {
+		t.Fatalf("Table.renderBytes() error = %v", err)
+	}
+	b, err := json.Marshal(tbl)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	decoded := NewTable(nil)
+	if err := json.Unmarshal(b, decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	got, err := decoded.renderBytes()
+	if err != nil {
+		t.Fatalf("Table.renderBytes() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded Table.renderBytes() -> %q, want %q", got, want)
+	}
+	if !reflect.DeepEqual(decoded.Spec(), tbl.Spec()) {
+		t.Errorf("decoded Table.Spec() -> %+v, want %+v", decoded.Spec(), tbl.Spec())
+	}
+	if tags, _ := decoded.RowTags(3); tags["status"] != "ok" {
+		t.Errorf("decoded Table.RowTags(3) -> %v, want status ok", tags)
+	}
+}
+
+func TestTable_Spec(t *testing.T) {
+	spec := newSpecTestTable().Spec()
+	if len(spec.Headers) != 1 || len(spec.Rows) != 3 || !reflect.DeepEqual(spec.Dividers, []int{2}) {
+		t.Errorf("Table.Spec() rows -> %v %v %v, want 1 header row, 3 rows, and 1 divider", spec.Headers, spec.Rows, spec.Dividers)
+	}
+	if spec.Styles[0] != nil || spec.Styles[2][1] != (Style{Bold: true}) || spec.Links[2][1] != "https://example.com" {
+		t.Errorf("Table.Spec() styles -> %v %v, want styles and links for the last row only", spec.Styles, spec.Links)
+	}
+	if c := spec.Columns[2]; c.Kind != KindCount {
+		t.Errorf("Table.Spec() column 2 -> %+v, want KindCount", c)
+	}
+	if spec := NewTable(nil).Spec(); !reflect.DeepEqual(spec, TableSpec{}) {
+		t.Errorf("Table.Spec() on default table -> %+v, want zero value", spec)
+	}
+}
+
+func TestNewTableFromSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    TableSpec
+		wantErr bool
+	}{
+		{"pass", TableSpec{Headers: [][]string{{"a"}}, Rows: [][]string{{"1"}}}, false},
+		{"ragged rows", TableSpec{Rows: [][]string{{"1"}, {"1", "2"}}}, true},
+		{"too few style rows", TableSpec{Rows: [][]string{{"1"}}, Styles: [][]Style{}}, true},
+		{"too few styles", TableSpec{Rows: [][]string{{"1", "2"}}, Styles: [][]Style{{{}}}}, true},
+		{"too few links", TableSpec{Rows: [][]string{{"1", "2"}}, Links: [][]string{{""}}}, true},
+		{"column widths", TableSpec{Rows: [][]string{{"1", "2"}}, ColumnWidths: []int{1}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTableFromSpec(nil, tt.spec); (err != nil) != tt.wantErr {
+				t.Errorf("NewTableFromSpec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	if err := json.Unmarshal([]byte(`{"rows": 1}`), NewTable(nil)); err == nil {
+		t.Errorf("json.Unmarshal() with invalid spec error = nil, want error")
+	}
+}
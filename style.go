@@ -0,0 +1,110 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ptiger10/tablewriter/layout"
+)
+
+// A Color is one of the 8 standard ANSI terminal colors.
+type Color int
+
+const (
+	// ColorDefault leaves the terminal color unchanged.
+	ColorDefault Color = iota
+	// ColorBlack is ANSI black.
+	ColorBlack
+	// ColorRed is ANSI red.
+	ColorRed
+	// ColorGreen is ANSI green.
+	ColorGreen
+	// ColorYellow is ANSI yellow.
+	ColorYellow
+	// ColorBlue is ANSI blue.
+	ColorBlue
+	// ColorMagenta is ANSI magenta.
+	ColorMagenta
+	// ColorCyan is ANSI cyan.
+	ColorCyan
+	// ColorWhite is ANSI white.
+	ColorWhite
+)
+
+// A Style configures the ANSI attributes applied to the text in a cell.
+// Uppercase transforms the text itself, and column widths are computed from the transformed text.
+// The zero value applies no attributes.
+type Style struct {
+	Foreground Color
+	Background Color
+	Bold       bool
+	Dim        bool
+	Underline  bool
+	Uppercase  bool
+	// link is the URL of the cell's hyperlink, set from Cell.Link
+	link string
+}
+
+// A Cell is a single text value in a table, along with the Style applied to it at render time.
+// If Link is set, the text is rendered as an OSC 8 terminal hyperlink to Link, which does not affect the width of the cell.
+// Terminals without hyperlink support display the text only. A Cell with a Link but no Text displays the Link itself.
+type Cell struct {
+	Text  string
+	Style Style
+	Link  string
+}
+
+// over returns the style with any attributes it does not set inherited from `base`.
+func (style Style) over(base Style) Style {
+	if style.Foreground == ColorDefault {
+		style.Foreground = base.Foreground
+	}
+	if style.Background == ColorDefault {
+		style.Background = base.Background
+	}
+	style.Bold = style.Bold || base.Bold
+	style.Dim = style.Dim || base.Dim
+	style.Underline = style.Underline || base.Underline
+	style.Uppercase = style.Uppercase || base.Uppercase
+	return style
+}
+
+// ANSI Select Graphic Rendition (SGR) escape sequences
+const (
+	sgrPrefix = "\x1b["
+	sgrSuffix = "m"
+	sgrReset  = sgrPrefix + "0" + sgrSuffix
+)
+
+// sgrCodes returns the SGR parameters for the style (e.g., ["1", "31"]), or nil if the style has no attributes.
+func (style Style) sgrCodes() []string {
+	var codes []string
+	if style.Bold {
+		codes = append(codes, "1")
+	}
+	if style.Dim {
+		codes = append(codes, "2")
+	}
+	if style.Underline {
+		codes = append(codes, "4")
+	}
+	if style.Foreground > ColorDefault && style.Foreground <= ColorWhite {
+		codes = append(codes, strconv.Itoa(30+int(style.Foreground-ColorBlack)))
+	}
+	if style.Background > ColorDefault && style.Background <= ColorWhite {
+		codes = append(codes, strconv.Itoa(40+int(style.Background-ColorBlack)))
+	}
+	return codes
+}
+
+// apply wraps `s` in the escape sequences for the style, followed by a reset, and in a hyperlink if the style has one.
+// Empty strings and styles without attributes are returned unchanged.
+func (style Style) apply(s string) string {
+	if s == "" {
+		return s
+	}
+	if codes := style.sgrCodes(); len(codes) > 0 {
+		s = sgrPrefix + strings.Join(codes, ";") + sgrSuffix + s + sgrReset
+	}
+	return layout.Hyperlink(s, style.link)
+}
@@ -0,0 +1,52 @@
+package tablewriter
+
+import "testing"
+
+func TestStyle_apply(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		s     string
+		want  string
+	}{
+		{"no attributes", Style{}, "foo", "foo"},
+		{"empty string", Style{Bold: true}, "", ""},
+		{"bold", Style{Bold: true}, "foo", "\x1b[1mfoo\x1b[0m"},
+		{"underline", Style{Underline: true}, "foo", "\x1b[4mfoo\x1b[0m"},
+		{"dim", Style{Dim: true}, "foo", "\x1b[2mfoo\x1b[0m"},
+		{"link", Style{link: "http://x"}, "foo", "\x1b]8;;http://x\x1b\\foo\x1b]8;;\x1b\\"},
+		{"bold link", Style{Bold: true, link: "http://x"}, "foo", "\x1b]8;;http://x\x1b\\\x1b[1mfoo\x1b[0m\x1b]8;;\x1b\\"},
+		{"foreground", Style{Foreground: ColorRed}, "foo", "\x1b[31mfoo\x1b[0m"},
+		{"background", Style{Background: ColorWhite}, "foo", "\x1b[47mfoo\x1b[0m"},
+		{"combined", Style{Bold: true, Foreground: ColorBlack, Background: ColorGreen}, "foo", "\x1b[1;30;42mfoo\x1b[0m"},
+		{"unsupported color ignored", Style{Foreground: Color(99)}, "foo", "foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.apply(tt.s); got != tt.want {
+				t.Errorf("Style.apply() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStyle_over(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		base  Style
+		want  Style
+	}{
+		{"inherit all", Style{}, Style{Foreground: ColorRed, Bold: true}, Style{Foreground: ColorRed, Bold: true}},
+		{"override color", Style{Background: ColorBlue}, Style{Background: ColorRed, Underline: true},
+			Style{Background: ColorBlue, Underline: true}},
+		{"combine attributes", Style{Bold: true}, Style{Uppercase: true}, Style{Bold: true, Uppercase: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.over(tt.base); got != tt.want {
+				t.Errorf("Style.over() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
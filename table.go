@@ -1,17 +1,19 @@
 package tablewriter
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"runtime"
 	"strings"
-	"unicode"
+
+	"github.com/ptiger10/tablewriter/layout"
 )
 
-// NewTable creates a default table writing to `w`.
-func NewTable(w io.Writer) *Table {
-	return &Table{
+// NewTable creates a default table writing to `w`, configured by any options supplied (see Option).
+func NewTable(w io.Writer, opts ...Option) *Table {
+	tbl := &Table{
 		w:                 w,
-		rows:              [][]string{},
 		alignment:         AlignCenter,
 		numHeaderRows:     0,
 		numLabelLevels:    0,
@@ -19,16 +21,88 @@ func NewTable(w io.Writer) *Table {
 		truncateCells:     false,
 		autoCenterHeaders: true,
 	}
+	for _, opt := range opts {
+		opt(tbl)
+	}
+	return tbl
+}
+
+// NewTableWithCapacity creates a default table writing to `w`, with room reserved for `rows` rows of `cols` columns each.
+// This reduces allocations when building and rendering very large tables in hot paths.
+func NewTableWithCapacity(w io.Writer, rows, cols int) *Table {
+	tbl := NewTable(w)
+	tbl.Grow(rows)
+	if cols > 0 {
+		tbl.scratch = &renderer{
+			rowCopy:   make([]string, 0, cols),
+			priorRow:  make([]string, 0, cols),
+			colWidths: make([]int, 0, cols),
+			paddings:  make([]cellPadding, 0, cols),
+		}
+	}
+	return tbl
+}
+
+// Grow reserves room for at least `rows` more rows, so that appending them does not reallocate the table's row storage.
+// If arena storage is enabled, room is also reserved for their cells.
+func (tbl *Table) Grow(rows int) {
+	tbl.rows.grow(rows)
+}
+
+// NumRows returns the number of non-header rows in the table.
+func (tbl *Table) NumRows() int {
+	return tbl.rows.len() - tbl.numHeaderRows
+}
+
+// NumHeaderRows returns the number of header rows in the table.
+func (tbl *Table) NumHeaderRows() int {
+	return tbl.numHeaderRows
+}
+
+// NumColumns returns the number of columns in the table, or 0 if the table has no rows.
+// Columns added at render time (e.g., computed columns or an auto index) are not counted.
+func (tbl *Table) NumColumns() int {
+	if tbl.rows.len() == 0 {
+		return 0
+	}
+	return len(tbl.rows.at(0).cells)
+}
+
+// Row returns a copy of the non-header row at position `i` (0 is the first non-header row), or nil if there is no such row.
+func (tbl *Table) Row(i int) []string {
+	if i < 0 || i >= tbl.NumRows() {
+		return nil
+	}
+	return copyRecord(tbl.rows.at(tbl.numHeaderRows + i)).cells
+}
+
+// Rows returns a copy of every non-header row in the table, as stored (before any render-time transformations).
+func (tbl *Table) Rows() [][]string {
+	return tbl.copyCells(tbl.numHeaderRows, tbl.rows.len())
+}
+
+// HeaderRows returns a copy of every header row in the table.
+func (tbl *Table) HeaderRows() [][]string {
+	return tbl.copyCells(0, tbl.numHeaderRows)
+}
+
+// copyCells returns a copy of the cells in rows [start, end).
+func (tbl *Table) copyCells(start, end int) [][]string {
+	ret := make([][]string, 0, end-start)
+	for i := start; i < end; i++ {
+		ret = append(ret, copyRecord(tbl.rows.at(i)).cells)
+	}
+	return ret
 }
 
 func (tbl *Table) sameShape(row []string) error {
 	// no rows in table? ok
-	if len(tbl.rows) == 0 {
+	if tbl.rows.len() == 0 {
 		return nil
 	}
 	// shape does not match? bad
-	if len(row) != len(tbl.rows[0]) {
-		return fmt.Errorf("new row must have same number of fields as all existing rows in Table (%d != %d)", len(row), len(tbl.rows[0]))
+	if numCols := len(tbl.rows.at(0).cells); len(row) != numCols {
+		return fmt.Errorf("new row must have same number of fields as all existing rows in Table (%d != %d)", len(row), numCols)
 	}
 	// shape matches? ok
 	return nil
@@ -36,27 +110,45 @@ func (tbl *Table) sameShape(row []string) error {
 
 // AppendHeaderRow appends a header row to the table.
 func (tbl *Table) AppendHeaderRow(row []string) error {
-	err := tbl.sameShape(row)
+	cells, err := tbl.reshape(row, -1)
 	if err != nil {
 		return fmt.Errorf("appending header row: %v", err)
 	}
-
-	headersOnly := make([][]string, tbl.numHeaderRows)
-	copy(headersOnly, tbl.rows[:tbl.numHeaderRows])
-	headersOnly = append(headersOnly, row)
-
-	tbl.rows = append(headersOnly, tbl.rows[tbl.numHeaderRows:]...)
+	tbl.rows.insert(tbl.numHeaderRows, record{cells: cells})
 	tbl.numHeaderRows++
 	return nil
 }
 
 // AppendRow appends a non-header row to the table.
+// Cells may contain line breaks (e.g., a nested table from RenderBlock), in which case the row expands vertically.
 func (tbl *Table) AppendRow(row []string) error {
-	err := tbl.sameShape(row)
+	cells, err := tbl.reshape(row, tbl.NumRows())
 	if err != nil {
 		return fmt.Errorf("appending row (%v): %v", row, err)
 	}
-	tbl.rows = append(tbl.rows, row)
+	tbl.rows.append(record{cells: cells})
+	return nil
+}
+
+// AppendStyledRow appends a non-header row to the table, styling each cell's text at render time.
+// Styles are emitted as ANSI escape sequences and do not affect the width of any column.
+func (tbl *Table) AppendStyledRow(row []Cell) error {
+	cells := make([]string, len(row))
+	styles := make([]Style, len(row))
+	for k := range row {
+		cells[k] = row[k].Text
+		if cells[k] == "" {
+			cells[k] = row[k].Link
+		}
+		styles[k] = row[k].Style
+		styles[k].link = row[k].Link
+	}
+	err := tbl.sameShape(cells)
+	if err != nil {
+		return fmt.Errorf("appending styled row (%v): %v", cells, err)
+	}
+	tbl.rows.append(record{cells: cells, styles: styles})
+	tbl.hasStyles = true
 	return nil
 }
 
@@ -71,11 +163,182 @@ func (tbl *Table) AppendRows(rows [][]string) error {
 	return nil
 }
 
+// AppendFromChannel appends every row received from `ch` as a non-header row, until `ch` is closed.
+// If a row has the wrong shape, it and all subsequent rows are discarded, but the channel is still drained,
+// so that senders are never blocked; the first error is returned once `ch` is closed.
+func (tbl *Table) AppendFromChannel(ch <-chan []string) error {
+	var err error
+	var i int
+	for row := range ch {
+		if err == nil {
+			if appendErr := tbl.AppendRow(row); appendErr != nil {
+				err = fmt.Errorf("tbl.AppendFromChannel(): position %d: %v", i, appendErr)
+			}
+		}
+		i++
+	}
+	return err
+}
+
+// EnableArenaStorage causes the cells in every subsequently appended row to be copied into large shared blocks of memory
+// (default: rows are stored as supplied).
+// This reduces per-cell allocations and garbage collection pressure for very large tables,
+// and allows the source strings to be garbage collected once they have been appended.
+func (tbl *Table) EnableArenaStorage() {
+	if tbl.rows.arena == nil {
+		tbl.rows.arena = &cellArena{}
+	}
+}
+
+// Clone returns a deep copy of the table's settings and header rows, which writes to the same io.Writer.
+// If `includeBody` is true, the non-header rows are copied as well.
+// Otherwise the clone can serve as a template for rendering many datasets with the same configuration.
+func (tbl *Table) Clone(includeBody bool) *Table {
+	clone := *tbl
+	clone.rows = rowStore{}
+	if tbl.rows.arena != nil {
+		clone.rows.arena = &cellArena{}
+	}
+	clone.scratch = nil
+	if tbl.zebraStripes != nil {
+		stripes := *tbl.zebraStripes
+		clone.zebraStripes = &stripes
+	}
+	if tbl.padding != nil {
+		padding := *tbl.padding
+		clone.padding = &padding
+	}
+	clone.columns = tbl.shiftColumns(0)
+	clone.metadata = append([]metadataEntry(nil), tbl.metadata...)
+	if tbl.fixedWidths != nil {
+		clone.fixedWidths = append([]int(nil), tbl.fixedWidths...)
+	}
+	if tbl.headerSubtext != nil {
+		clone.headerSubtext = append([]string(nil), tbl.headerSubtext...)
+	}
+	clone.computed = append([]computedColumn(nil), tbl.computed...)
+	if tbl.groupBy != nil {
+		clone.GroupBy(tbl.groupBy.col, tbl.groupBy.aggregators)
+	}
+	clone.footer = nil
+	for k, agg := range tbl.footer {
+		clone.AddFooterAggregate(k, agg)
+	}
+	clone.dividers = nil
+	if includeBody {
+		clone.dividers = append([]int(nil), tbl.dividers...)
+	}
+	numRows := tbl.numHeaderRows
+	if includeBody {
+		numRows = tbl.rows.len()
+	}
+	for i := 0; i < numRows; i++ {
+		clone.rows.append(copyRecord(tbl.rows.at(i)))
+	}
+	return &clone
+}
+
+// MatchColumnNamesExactly causes columns addressed by header name to match only if the names are identical
+// (default: names match case-insensitively, ignoring differences in whitespace, unless an identical name exists).
+func (tbl *Table) MatchColumnNamesExactly() {
+	tbl.exactColumnNames = true
+}
+
+// ClearRows removes all non-header rows from the table, keeping its header rows and settings.
+// Memory is retained for reuse, so a long-lived table can be refreshed and re-rendered repeatedly.
+func (tbl *Table) ClearRows() {
+	tbl.rows.truncate(tbl.numHeaderRows)
+	tbl.dividers = tbl.dividers[:0]
+}
+
+// AppendDivider inserts a horizontal dividing line after the last non-header row, separating it from any rows appended later
+// (e.g., between sections of a report). Dividers are drawn like the top and bottom borders, at the same column widths,
+// and stay between the same positions in the table body if rows are sorted or filtered.
+// Dividers before the first non-header row or after the last one are not drawn.
+func (tbl *Table) AppendDivider() {
+	tbl.dividers = append(tbl.dividers, tbl.rows.len()-tbl.numHeaderRows)
+}
+
+// Reset removes all rows from the table, including header rows, and restores the default settings.
+// The table continues to write to the same io.Writer.
+func (tbl *Table) Reset() {
+	scratch := tbl.scratch
+	tbl.rows.truncate(0)
+	rows := tbl.rows
+	rows.arena = nil
+	*tbl = *NewTable(tbl.w)
+	tbl.rows = rows
+	tbl.scratch = scratch
+}
+
+// A headerDivider holds the symbols of the header border of a single table.
+type headerDivider struct {
+	filler, edge string
+}
+
+// SetHeaderDivider draws the border below the header rows of the table with `filler` (e.g., "=" or "═")
+// and, unless `edge` is empty, with `edge` (e.g., "╪") wherever it meets a vertical edge, making the headers visually stronger.
+// Both symbols must be 1-rune wide. Label edges are unaffected.
+// This overrides the library-wide HeaderFiller and header junction symbols (see Defaults) for this table only.
+func (tbl *Table) SetHeaderDivider(filler, edge string) error {
+	if !singleWidthString(filler) {
+		return fmt.Errorf("tbl.SetHeaderDivider(): filler must be 1 rune wide (%q)", filler)
+	}
+	if edge != "" && !singleWidthString(edge) {
+		return fmt.Errorf("tbl.SetHeaderDivider(): edge must be empty or 1 rune wide (%q)", edge)
+	}
+	tbl.headerDivider = &headerDivider{filler: filler, edge: edge}
+	return nil
+}
+
 // DisableHeaderAutoCentering causes header cells to be aligned based on the underlying table alignment (default: headers are auto-centered).
 func (tbl *Table) DisableHeaderAutoCentering() {
 	tbl.autoCenterHeaders = false
 }
 
+// SetHeaderStyle sets the style of every cell in the header rows to `style`, independent of the styles in non-header rows
+// (default: no header styling).
+func (tbl *Table) SetHeaderStyle(style Style) {
+	tbl.headerStyle = style
+}
+
+// SetHeaderSubtext adds a secondary header line of dimmed text (e.g., units or descriptions) below the header rows and above the header divider.
+// Subtext is centered and wrapped like the header rows, and does not count as a header row in the stored table.
+// A nil `subtext` removes any existing subtext.
+func (tbl *Table) SetHeaderSubtext(subtext []string) error {
+	if subtext == nil {
+		tbl.headerSubtext = nil
+		return nil
+	}
+	err := tbl.sameShape(subtext)
+	if err != nil {
+		return fmt.Errorf("tbl.SetHeaderSubtext(): %v", err)
+	}
+	tbl.headerSubtext = append([]string(nil), subtext...)
+	return nil
+}
+
+// EnableZebraStripes alternates the style of non-header rows between `even` (first, third, ...) and `odd` (second, fourth, ...)
+// to improve the readability of wide tables in ANSI-capable terminals (default: no stripes).
+// Any styles set on individual cells take precedence.
+func (tbl *Table) EnableZebraStripes(even, odd Style) {
+	tbl.zebraStripes = &[2]Style{even, odd}
+}
+
+// SetEmptyCellPlaceholder displays `placeholder` (e.g., "-" or "n/a") in place of empty non-header cells at render time.
+// If `includeMerged` is false, cells blanked by MergeRepeats stay blank; otherwise they display the placeholder as well.
+// (Default: empty cells are blank).
+func (tbl *Table) SetEmptyCellPlaceholder(placeholder string, includeMerged bool) {
+	tbl.placeholder = placeholder
+	tbl.placeholderMerged = includeMerged
+}
+
+// EnableStrictWidths causes rendering to return an error if any cell is wider than its column, instead of wrapping or truncating it.
+// This suits machine-parsed fixed-width output, where a change in layout would break consumers.
+func (tbl *Table) EnableStrictWidths() {
+	tbl.strictWidths = true
+}
+
 // MergeRepeats merges all repeated values in a column together.
 func (tbl *Table) MergeRepeats() {
 	tbl.autoMerge = true
@@ -86,11 +349,197 @@ func (tbl *Table) TruncateWideCells() {
 	tbl.truncateCells = true
 }
 
+// SetMaxLinesPerCell limits every cell to at most `n` lines when it is wrapped or contains line breaks (default: no limit),
+// so that pathologically long cells cannot blow up the height of a table.
+// If a cell has more lines, the last line that is shown ends with "...". Use 0 to remove the limit.
+func (tbl *Table) SetMaxLinesPerCell(n int) {
+	if n < 0 {
+		n = 0
+	}
+	tbl.maxLinesPerCell = n
+}
+
+// SetLineEnding ends every rendered line with `ending`: LineEndingLF (default), LineEndingCRLF, or LineEndingAuto
+// to use the native line ending of the operating system. Line breaks within cells are rendered as separate lines,
+// so they are converted as well.
+func (tbl *Table) SetLineEnding(ending string) error {
+	switch ending {
+	case LineEndingLF:
+		tbl.crlf = false
+	case LineEndingCRLF:
+		tbl.crlf = true
+	case LineEndingAuto:
+		tbl.crlf = runtime.GOOS == "windows"
+	default:
+		return fmt.Errorf("tbl.SetLineEnding(): line ending must be %q, %q, or %q, not %q", LineEndingLF, LineEndingCRLF, LineEndingAuto, ending)
+	}
+	return nil
+}
+
+// SetHeaderOverflow sets how header cells wider than the max column width are handled, so that verbose headers keep tables narrow.
+// With OverflowWrap or OverflowTruncate, header cells are sized like non-header cells: they widen their column
+// only up to the max column width (or the width of the widest non-header cell, if greater), and the rest wraps or is truncated.
+// Header cells in columns with OverflowNone are never narrowed. (Default: OverflowDefault, header cells widen their column to fit).
+func (tbl *Table) SetHeaderOverflow(overflow Overflow) {
+	tbl.headerOverflow = overflow
+}
+
+// limitHeaders returns true if header cells are sized like non-header cells.
+func (tbl *Table) limitHeaders() bool {
+	return tbl.headerOverflow == OverflowWrap || tbl.headerOverflow == OverflowTruncate
+}
+
+// SetWrapContinuation prefixes every continuation line of a wrapped non-header cell with `marker` (e.g., "↳ "),
+// so that wrapped cells are visually distinguishable from new rows. A marker of spaces creates a hanging indent.
+// Continuation lines are wrapped to the column width less the width of the marker, and lines that follow a line break
+// in the cell are not continuation lines. An empty marker restores the default (no marker).
+func (tbl *Table) SetWrapContinuation(marker string) {
+	tbl.continuationMarker = marker
+}
+
+// SetWrapContinuationAlignment aligns every continuation line of a wrapped non-header cell with `alignment`,
+// instead of the alignment of its column (e.g., AlignLeft for a hanging indent in a right-aligned column).
+func (tbl *Table) SetWrapContinuationAlignment(alignment Alignment) {
+	tbl.continuationAlignment = alignment
+	tbl.hasContinuationAlignment = true
+}
+
+// TrimTrailingWhitespace strips trailing spaces from the end of every rendered line
+// (default: cells are padded to the full column width, even in the rightmost column).
+// This is useful for diff-friendly output when the content edge is blank.
+func (tbl *Table) TrimTrailingWhitespace() {
+	tbl.trimTrailingSpace = true
+}
+
+// DisableHyperlinks renders the text of cells with a Link as plain text, for terminals that mishandle OSC 8 hyperlinks
+// (default: links are rendered as hyperlinks).
+func (tbl *Table) DisableHyperlinks() {
+	tbl.plainLinks = true
+}
+
+// EscapeSeparators replaces the content edge symbol (default: "|") wherever it appears within a cell at render time,
+// so that cell text cannot be mistaken for a column boundary by readers or downstream parsers.
+// Each occurrence is replaced by `replacement` (e.g., "¦"), or escaped with a backslash (e.g., "\|") if `replacement` is empty.
+// (Default: cells are rendered as stored).
+func (tbl *Table) EscapeSeparators(replacement string) {
+	tbl.escapeSeparators = true
+	tbl.separatorReplacement = replacement
+}
+
+// SetTabWidth expands each tab in a cell at render time to the next multiple of `n` runes within its line,
+// preserving any alignment intended within the cell (e.g., pretty-printed sub-content).
+// Column widths are computed from the expanded text. Values less than 1 restore the default. (Default: cells are rendered as stored).
+func (tbl *Table) SetTabWidth(n int) {
+	tbl.tabWidth = n
+}
+
+// StripControlCharacters sanitizes every cell at render time so that raw text (e.g., log data) cannot break the alignment of the table:
+// each tab is replaced by a space (unless expanded by SetTabWidth), and every other control character except line breaks (e.g., carriage returns) is removed.
+// If `escape` is true, those control characters are instead displayed as escapes (e.g., "\x0d").
+// ANSI styling sequences within cells are retained. (Default: cells are rendered as stored).
+func (tbl *Table) StripControlCharacters(escape bool) {
+	tbl.stripControls = true
+	tbl.escapeControls = escape
+}
+
+// EnableAutoIndex prepends a label column that numbers the non-header rows from 1 to N at render time
+// (default: no index column). The index column has a blank header.
+func (tbl *Table) EnableAutoIndex() {
+	tbl.autoIndex = true
+}
+
+// Suppress omits one or more parts of the table when it is rendered, in addition to any parts already suppressed
+// (default: nothing is suppressed). Combine parts with bitwise OR (e.g., SuppressTopBorder|SuppressBottomBorder).
+// Column widths are still computed from every row, so that fragments of the same table can be concatenated seamlessly.
+func (tbl *Table) Suppress(parts Suppression) {
+	tbl.suppress |= parts
+}
+
+// suppressed returns true if `part` is suppressed.
+func (tbl *Table) suppressed(part Suppression) bool {
+	return tbl.suppress&part != 0
+}
+
+// SetBorders shows or hides each of the outer borders of the table, while keeping the internal column separators and the header divider.
+// (Default: all borders are shown).
+func (tbl *Table) SetBorders(top, bottom, left, right bool) {
+	borders := []struct {
+		show bool
+		part Suppression
+	}{{top, SuppressTopBorder}, {bottom, SuppressBottomBorder}, {left, SuppressLeftBorder}, {right, SuppressRightBorder}}
+	for _, b := range borders {
+		if b.show {
+			tbl.suppress &^= b.part
+		} else {
+			tbl.suppress |= b.part
+		}
+	}
+}
+
+// DisableBorders hides the frame around the table, while keeping the internal column separators and the header divider.
+// It is equivalent to SetBorders(false, false, false, false).
+func (tbl *Table) DisableBorders() {
+	tbl.SetBorders(false, false, false, false)
+}
+
+// SetColumnSpacing renders the table without borders, dividing rows, or column separators,
+// with columns separated by `n` spaces instead, like the output of text/tabwriter.
+// Cell padding is ignored and trailing spaces are trimmed, but wrapping, truncation, and alignment still apply.
+// Values less than 1 restore the bordered layout. (Default: 0).
+func (tbl *Table) SetColumnSpacing(n int) {
+	tbl.spacing = n
+}
+
+// separators returns the symbols between columns, and between the label levels and the remaining columns.
+func (tbl *Table) separators() (edge, labelEdge string) {
+	if tbl.spacing > 0 {
+		spaces := strings.Repeat(" ", tbl.spacing)
+		return spaces, spaces
+	}
+	return contentEdge, contentLabelEdge
+}
+
 // SetAlignment sets the alignment of cells in content rows to `alignment`.
 func (tbl *Table) SetAlignment(alignment Alignment) {
 	tbl.alignment = alignment
 }
 
+// SetMaxColumnWidth sets the maximum width of the non-header cells in every column of the table,
+// overriding the library-wide MaxColWidth (see ChangeDefaults). Values less than 1 restore the library-wide setting.
+func (tbl *Table) SetMaxColumnWidth(n int) {
+	tbl.maxWidth = n
+}
+
+// maxColumnWidth returns the table-specific or library-wide maximum column width.
+func (tbl *Table) maxColumnWidth() int {
+	if tbl.maxWidth > 0 {
+		return tbl.maxWidth
+	}
+	return maxColWidth
+}
+
+// SetDefaultMinColumnWidth sets the minimum width of every column (excluding padding) without its own minimum (see SetMinColumnWidth) to `n`.
+// Fitting the table to a total width never narrows a column below its minimum,
+// which guards against degenerate layouts where cells shrink to a single character. (Default: 0).
+func (tbl *Table) SetDefaultMinColumnWidth(n int) {
+	tbl.minWidth = n
+}
+
+// minColumnWidth returns the minimum width of column `k`, which is the column-specific or table-wide minimum.
+func (tbl *Table) minColumnWidth(k int) int {
+	if minWidth := tbl.column(k).minWidth; minWidth > 0 {
+		return minWidth
+	}
+	return tbl.minWidth
+}
+
+// SetPadding sets the number of spaces on the left and right of the text in every cell to `left` and `right`.
+// Dense tables can use zero padding, and report-style tables can use wider gutters. Negative values are treated as 0.
+// (Default: 1 space on either side).
+func (tbl *Table) SetPadding(left, right int) {
+	tbl.padding = newCellPadding(left, right)
+}
+
 // SetLabelLevelCount sets the number of label levels to `n`.
 // "Label levels" are the leftmost columns in the table, and typically have values that help identify ("label") specific rows.
 // They are often analogous to a table index.
@@ -100,132 +549,601 @@ func (tbl *Table) SetLabelLevelCount(n int) {
 	tbl.numLabelLevels = n
 }
 
+// SetLabelSide sets the side of the table on which the label levels appear to `side`.
+// With SideRight, the label levels are the rightmost columns in the table, and any auto index is appended after the last column.
+// This suits right-to-left locales and tables where the key column is conventionally last.
+// (Default: SideLeft).
+func (tbl *Table) SetLabelSide(side Side) {
+	tbl.labelSide = side
+}
+
+// labelEdge returns the index of the column followed by the label edge in a table with `numCols` columns,
+// or -1 if there is no label edge.
+func (tbl *Table) labelEdge(numCols int) int {
+	if tbl.numLabelLevels <= 0 {
+		return -1
+	}
+	if tbl.labelSide == SideRight {
+		return numCols - tbl.numLabelLevels - 1
+	}
+	return tbl.numLabelLevels - 1
+}
+
 // creates a stringified representation of content rows and dividing rows
 func (tbl *Table) render() (string, error) {
-	if len(tbl.rows) == 0 {
-		return "", fmt.Errorf("table must have at least 1 row")
+	b, err := tbl.renderBytes()
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// checkRenderable returns an error if the table cannot be rendered:
+// it has no rows, or an error was recorded while configuring it with chainable methods or options.
+func (tbl *Table) checkRenderable() error {
+	if tbl.configErr != nil {
+		return tbl.configErr
+	}
+	if tbl.rows.len() == 0 {
+		return fmt.Errorf("table must have at least 1 row")
 	}
-	colWidths := tbl.resizeColWidths()
-	borderLine := stringifyDividingRow(colWidths, tbl.numLabelLevels, false)
-	headerLine := stringifyDividingRow(colWidths, tbl.numLabelLevels, true)
+	return nil
+}
 
-	var ret string
-	var priorRow []string
-	for i := range tbl.rows {
+// like render, but writes into the table's reusable scratch buffer.
+// the returned slice is only valid until the next render.
+func (tbl *Table) renderBytes() ([]byte, error) {
+	if err := tbl.checkRenderable(); err != nil {
+		return nil, err
+	}
+	if tbl.scratch == nil {
+		tbl.scratch = &renderer{}
+	}
+	r := tbl.scratch
+	// the trailer describes the stored rows rather than the transformed rows
+	var trailer string
+	if tbl.trailer {
+		trailer = tbl.stringifyTrailer()
+	}
+	// apply render-time transformations to a copy of the table, leaving the original unchanged
+	tbl = tbl.view()
+	spec, err := tbl.layoutColumns(r)
+	if err != nil {
+		return nil, err
+	}
+	colWidths := r.colWidths
+	var footnote string
+	if tbl.clipIndicator != ClipNone {
+		clipped := tbl.clippedColumns(colWidths)
+		if tbl.clipIndicator == ClipMarkHeader {
+			tbl.markClippedHeaders(clipped, colWidths)
+		} else {
+			footnote = tbl.clipFootnote(clipped)
+		}
+	}
+	borderLine := stringifyDividingRow(colWidths, spec, dividingTop)
+	headerLine := stringifyDividingRow(colWidths, spec, dividingHeader)
+	middleLine := stringifyDividingRow(colWidths, spec, dividingMiddle)
+	bottomLine := stringifyDividingRow(colWidths, spec, dividingBottom)
+
+	// estimate the output size from the layout (one line per row, plus dividing rows) to avoid repeatedly growing the buffer
+	ret := &r.out
+	ret.Reset()
+	ret.Grow(estimateSize(len(borderLine), tbl.rows.len(), tbl.numHeaderRows))
+	if tbl.metadataPosition == MetadataAbove {
+		ret.WriteString(tbl.stringifyMetadata())
+	}
+	// merging on the middle row of each group requires knowing the extent of every group in advance
+	var mask [][]bool
+	if tbl.autoMerge && tbl.mergePosition == MergeMiddle {
+		mask = tbl.mergeMask()
+	}
+	// d is the position of the next divider to write
+	var d int
+	for i := 0; i < tbl.rows.len(); i++ {
+		row := tbl.rows.at(i)
+		isHeader := i < tbl.numHeaderRows
 		// write a borderLine at the top and a headerLine after the last header row
-		if i == 0 {
-			ret += borderLine
-		} else if i == tbl.numHeaderRows {
-			ret += headerLine
-		}
-		// copy row to avoid changing original in calls to autoMergeRows and stringifyContentRow
-		rowCopy := make([]string, len(tbl.rows[i]))
-		copy(rowCopy, tbl.rows[i])
-		if tbl.autoMerge {
-			// auto-merge applies only to non-header rows
-			if i == tbl.numHeaderRows+1 {
-				priorRow = tbl.rows[tbl.numHeaderRows]
+		if i == 0 && !tbl.suppressed(SuppressTopBorder) {
+			ret.WriteString(borderLine)
+		}
+		if i > 0 && i == tbl.numHeaderRows && !tbl.suppressed(SuppressHeaders) {
+			ret.WriteString(headerLine)
+		}
+		if isHeader && tbl.suppressed(SuppressHeaders) {
+			continue
+		}
+		// write a middleLine for any dividers before this row, skipping any before the first non-header row
+		body := i - tbl.numHeaderRows
+		for d < len(tbl.dividers) && tbl.dividers[d] < body {
+			d++
+		}
+		if body > 0 && d < len(tbl.dividers) && tbl.dividers[d] == body {
+			ret.WriteString(middleLine)
+		}
+		// copy row to avoid changing original in calls to autoMergeRows and writeContentRow
+		rowCopy := r.copyRow(row.cells)
+		tbl.mergeCells(r, mask, i, rowCopy)
+		tbl.writeContentRow(ret, colWidths, rowCopy, row.styles, isHeader)
+	}
+	// write a bottomLine at the bottom
+	if !tbl.suppressed(SuppressBottomBorder) {
+		ret.WriteString(bottomLine)
+	}
+	ret.WriteString(footnote)
+	ret.WriteString(trailer)
+	if tbl.metadataPosition == MetadataBelow {
+		ret.WriteString(tbl.stringifyMetadata())
+	}
+	if tbl.trimTrailingSpace || tbl.spacing > 0 {
+		return tbl.withLineEndings([]byte(trimTrailingSpaces(ret.String()))), nil
+	}
+	return tbl.withLineEndings(ret.Bytes()), nil
+}
+
+// withLineEndings returns `b`, or a copy of `b` with every "\n" replaced by the table's line ending.
+func (tbl *Table) withLineEndings(b []byte) []byte {
+	if !tbl.crlf {
+		return b
+	}
+	return bytes.ReplaceAll(b, []byte("\n"), []byte(LineEndingCRLF))
+}
+
+// newline returns the table's line ending.
+func (tbl *Table) newline() string {
+	if tbl.crlf {
+		return LineEndingCRLF
+	}
+	return LineEndingLF
+}
+
+// mergeCells blanks the repeat values in `cells`, a copy of the row at position `i` in a view, if repeat values are merged,
+// and fills the merged cells with the placeholder if requested.
+// `mask` is the result of mergeMask if repeat values are merged on their middle row, and rows must be merged in order.
+func (tbl *Table) mergeCells(r *renderer, mask [][]bool, i int, cells []string) {
+	if mask != nil && i >= tbl.numHeaderRows {
+		for k, show := range mask[i-tbl.numHeaderRows] {
+			if !show {
+				cells[k] = ""
+			}
+		}
+	} else if tbl.autoMerge {
+		// auto-merge applies only to non-header rows, and restarts after every divider unless merged across the table
+		if i == tbl.numHeaderRows || (i > tbl.numHeaderRows && tbl.startsMergeSection(i)) {
+			r.priorRow = append(r.priorRow[:0], cells...)
+		} else if i > tbl.numHeaderRows {
+			autoMergeRows(r.priorRow, cells, tbl.mergeScope == MergeScopeNested)
+		}
+	}
+	if tbl.placeholderMerged && tbl.autoMerge && i >= tbl.numHeaderRows {
+		for k := range cells {
+			if cells[k] == "" {
+				cells[k] = tbl.placeholder
 			}
-			autoMergeRows(priorRow, rowCopy)
 		}
-		isHeader := i < tbl.numHeaderRows
-		ret += tbl.stringifyContentRow(colWidths, rowCopy, isHeader)
 	}
-	// write a borderLine at the bottom
-	ret += borderLine
-	return ret, nil
+}
+
+// layoutColumns computes the final width of every column in a view (stored in r.colWidths)
+// and the layout of every rendered line (whose paddings are stored in r.paddings).
+func (tbl *Table) layoutColumns(r *renderer) (rowLayout, error) {
+	r.colWidths = tbl.resizeColWidthsInto(r.colWidths)
+	colWidths := r.colWidths
+	labelEdge := tbl.labelEdge(len(colWidths))
+	r.paddings = tbl.paddingsInto(r.paddings, len(colWidths))
+	spec := rowLayout{
+		paddings:      r.paddings,
+		labelEdge:     labelEdge,
+		noLeftEdge:    tbl.suppressed(SuppressLeftBorder) || tbl.spacing > 0,
+		noRightEdge:   tbl.suppressed(SuppressRightBorder) || tbl.spacing > 0,
+		headerDivider: tbl.headerDivider,
+		spacing:       tbl.spacing,
+	}
+	if tbl.fixedWidths != nil {
+		if len(tbl.fixedWidths) != len(colWidths) {
+			return rowLayout{}, fmt.Errorf("column widths: must have one width per column (%d != %d)", len(tbl.fixedWidths), len(colWidths))
+		}
+		copy(colWidths, tbl.fixedWidths)
+	} else if tbl.totalWidth > 0 {
+		priorities := make([]ShrinkPriority, len(colWidths))
+		minWidths := make([]int, len(colWidths))
+		for k := range priorities {
+			priorities[k] = tbl.column(k).priority
+			minWidths[k] = tbl.minColumnWidth(k)
+		}
+		fitTotalWidth(colWidths, spec, tbl.totalWidth, priorities, minWidths)
+	}
+	if tbl.widthObserver != nil {
+		adjusted := tbl.widthObserver(append([]int(nil), colWidths...))
+		if adjusted != nil {
+			if err := checkObservedWidths(adjusted, len(colWidths)); err != nil {
+				return rowLayout{}, err
+			}
+			copy(colWidths, adjusted)
+		}
+	}
+	if tbl.strictWidths {
+		if err := tbl.checkFit(colWidths); err != nil {
+			return rowLayout{}, err
+		}
+	}
+	return spec, nil
+}
+
+// Dimensions returns the width (in terminal columns) and height (in lines) of the table as it would be rendered,
+// without writing any output. This helps callers decide whether to fall back to another layout or to paging.
+func (tbl *Table) Dimensions() (width, height int, err error) {
+	b, err := tbl.renderBytes()
+	if err != nil {
+		return 0, 0, fmt.Errorf("tbl.Dimensions(): %v", err)
+	}
+	for _, line := range strings.SplitAfter(string(b), "\n") {
+		if line == "" {
+			continue
+		}
+		height++
+		if w := layout.Width(strings.TrimSuffix(line, tbl.newline())); w > width {
+			width = w
+		}
+	}
+	return width, height, nil
+}
+
+// RenderBlock returns the rendered table as a multi-line block without a trailing newline,
+// suitable for embedding as a cell value in another table (e.g., to render a structured sub-record).
+// Columns containing nested tables should typically use OverflowNone, so that lines of the block wider than the maximum column width are not wrapped.
+func (tbl *Table) RenderBlock() (string, error) {
+	b, err := tbl.renderBytes()
+	if err != nil {
+		return "", fmt.Errorf("tbl.RenderBlock(): %v", err)
+	}
+	return strings.TrimSuffix(string(b), tbl.newline()), nil
+}
+
+// estimateSize returns the approximate number of bytes in a rendered table with lines of `lineSize` bytes,
+// assuming that no cells wrap onto multiple lines.
+func estimateSize(lineSize, numRows, numHeaderRows int) int {
+	numLines := numRows + 2
+	if numHeaderRows > 0 && numHeaderRows < numRows {
+		numLines++
+	}
+	return lineSize * numLines
 }
 
 // Render creates a stringified representation of content rows and dividing rows
 // and writes the results into the table's io.Writer.
 func (tbl *Table) Render() error {
-	s, err := tbl.render()
+	b, err := tbl.renderBytes()
 	if err != nil {
 		return fmt.Errorf("tbl.Render(): %v", err)
 	}
-	_, err = tbl.w.Write([]byte(s))
+	_, err = tbl.w.Write(b)
 	if err != nil {
 		return fmt.Errorf("tbl.Render(): %v", err)
 	}
 	return nil
 }
 
-// modify priorRow and currentRow in place
-func autoMergeRows(priorRow, currentRow []string) {
+// RenderTo is like Render, but writes the results into `w` instead of the table's io.Writer,
+// so that one table can be written to several destinations (e.g., stdout and a log file).
+func (tbl *Table) RenderTo(w io.Writer) error {
+	b, err := tbl.renderBytes()
+	if err != nil {
+		return fmt.Errorf("tbl.RenderTo(): %v", err)
+	}
+	_, err = w.Write(b)
+	if err != nil {
+		return fmt.Errorf("tbl.RenderTo(): %v", err)
+	}
+	return nil
+}
+
+// RenderRange is like Render, but renders only the non-header rows in [start, end) (0 is the first non-header row),
+// along with the header rows and borders, for cheap windowed views over a large table (e.g., a scrolling view in a terminal UI).
+// Only the rows in the range are measured and transformed: footers, groups, and percentages of totals summarize just those rows,
+// and auto index numbers continue from `start`. Use SetColumnWidths to keep column widths identical across ranges.
+func (tbl *Table) RenderRange(start, end int) error {
+	if numRows := tbl.NumRows(); start < 0 || end > numRows || start > end {
+		return fmt.Errorf("tbl.RenderRange(): range [%d:%d] out of bounds [0:%d]", start, end, numRows)
+	}
+	b, err := tbl.rowRange(start, end).renderBytes()
+	if err != nil {
+		return fmt.Errorf("tbl.RenderRange(): %v", err)
+	}
+	_, err = tbl.w.Write(b)
+	if err != nil {
+		return fmt.Errorf("tbl.RenderRange(): %v", err)
+	}
+	return nil
+}
+
+// rowRange returns a shallow copy of the table with only its header rows and the non-header rows in [start, end).
+// Dividers within the range are kept.
+func (tbl *Table) rowRange(start, end int) *Table {
+	ret := *tbl
+	ret.rows = rowStore{}
+	ret.rows.grow(tbl.numHeaderRows + end - start)
+	for i := 0; i < tbl.numHeaderRows; i++ {
+		ret.rows.push(tbl.rows.at(i))
+	}
+	for i := start; i < end; i++ {
+		ret.rows.push(tbl.rows.at(tbl.numHeaderRows + i))
+	}
+	ret.dividers = nil
+	for _, d := range tbl.dividers {
+		if d > start && d < end {
+			ret.dividers = append(ret.dividers, d-start)
+		}
+	}
+	ret.indexOffset = tbl.indexOffset + start
+	if tbl.scratch == nil {
+		tbl.scratch = &renderer{}
+	}
+	ret.scratch = tbl.scratch
+	return &ret
+}
+
+// RenderWindow is like Render, but renders only the columns in [firstCol, lastCol] (starting at 0) along with the first `frozenCols` columns,
+// which are always shown on the left as label levels (replacing any other label levels), for navigating wide tables horizontally
+// (e.g., scrolling a terminal UI while keeping a key column in view). Frozen columns within the range are shown once.
+// Column settings, footer aggregates, and grouping apply to the columns shown, and computed columns are omitted.
+// Use SetColumnWidths on the full table to keep column widths identical across windows.
+func (tbl *Table) RenderWindow(firstCol, lastCol int, frozenCols int) error {
+	numCols := tbl.NumColumns()
+	if firstCol < 0 || lastCol >= numCols || firstCol > lastCol {
+		return fmt.Errorf("tbl.RenderWindow(): columns [%d:%d] out of bounds [0:%d]", firstCol, lastCol, numCols-1)
+	}
+	if frozenCols < 0 || frozenCols > numCols {
+		return fmt.Errorf("tbl.RenderWindow(): frozen columns (%d) out of range [0:%d]", frozenCols, numCols)
+	}
+	cols := make([]int, 0, frozenCols+lastCol-firstCol+1)
+	for k := 0; k < frozenCols; k++ {
+		cols = append(cols, k)
+	}
+	if firstCol < frozenCols {
+		firstCol = frozenCols
+	}
+	for k := firstCol; k <= lastCol; k++ {
+		cols = append(cols, k)
+	}
+	window := tbl.columnWindow(cols)
+	window.numLabelLevels = frozenCols
+	b, err := window.renderBytes()
+	if err != nil {
+		return fmt.Errorf("tbl.RenderWindow(): %v", err)
+	}
+	_, err = tbl.w.Write(b)
+	if err != nil {
+		return fmt.Errorf("tbl.RenderWindow(): %v", err)
+	}
+	return nil
+}
+
+// columnWindow returns a shallow copy of the table in which column k contains the original column cols[k].
+// Column settings, footer aggregates, grouping, header subtext, and fixed widths move with their columns,
+// and computed columns are dropped. The original table is unchanged.
+func (tbl *Table) columnWindow(cols []int) *Table {
+	ret := *tbl
+	ret.rows = rowStore{}
+	ret.rows.grow(tbl.rows.len())
+	for i := 0; i < tbl.rows.len(); i++ {
+		ret.rows.push(remapRecord(tbl.rows.at(i), cols))
+	}
+	if tbl.headerSubtext != nil {
+		ret.headerSubtext = remapRecord(record{cells: tbl.headerSubtext}, cols).cells
+	}
+	// fixed widths include any auto index, which is always kept
+	if tbl.fixedWidths != nil {
+		offset := 0
+		if tbl.autoIndex && tbl.labelSide != SideRight {
+			offset = 1
+		}
+		ret.fixedWidths = make([]int, 0, len(cols)+1)
+		if offset == 1 {
+			ret.fixedWidths = append(ret.fixedWidths, tbl.fixedWidths[0])
+		}
+		for _, k := range cols {
+			if k+offset < len(tbl.fixedWidths) {
+				ret.fixedWidths = append(ret.fixedWidths, tbl.fixedWidths[k+offset])
+			}
+		}
+		if tbl.autoIndex && tbl.labelSide == SideRight {
+			ret.fixedWidths = append(ret.fixedWidths, tbl.fixedWidths[len(tbl.fixedWidths)-1])
+		}
+	}
+	ret.computed = nil
+	moved := make(map[int]int, len(cols))
+	for k, col := range cols {
+		moved[col] = k
+	}
+	ret.moveColumnSettings(func(k int) int {
+		if n, ok := moved[k]; ok {
+			return n
+		}
+		return -1
+	})
+	if tbl.scratch == nil {
+		tbl.scratch = &renderer{}
+	}
+	ret.scratch = tbl.scratch
+	return &ret
+}
+
+// strip spaces from the end of every line in `s`
+func trimTrailingSpaces(s string) string {
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// modify priorRow and currentRow in place.
+// If `nested`, every value after the first changed value in the row is displayed.
+func autoMergeRows(priorRow, currentRow []string, nested bool) {
+	changed := false
 	for k := range priorRow {
-		if priorRow[k] == currentRow[k] {
+		if priorRow[k] == currentRow[k] && !changed {
 			currentRow[k] = ""
 		} else {
 			priorRow[k] = currentRow[k]
+			changed = nested
 		}
 	}
 }
 
+// counts runes without allocating a []rune.
+// ANSI control sequences embedded in `s` are zero-width.
 func runeWidth(s string) int {
-	return len([]rune(s))
+	return layout.Width(s)
+}
+
+// blockWidth returns the rune width of the widest line in `s`.
+func blockWidth(s string) int {
+	var ret int
+	for {
+		i := strings.IndexByte(s, '\n')
+		if i < 0 {
+			break
+		}
+		if w := runeWidth(s[:i]); w > ret {
+			ret = w
+		}
+		s = s[i+1:]
+	}
+	if w := runeWidth(s); w > ret {
+		ret = w
+	}
+	return ret
 }
 
 // expects all rows to have the same number of columns
-// expects len(tbl.rows) to be greater than 0.
+// expects tbl.rows.len() to be greater than 0.
 func (tbl *Table) resizeColWidths() []int {
-	ret := make([]int, len(tbl.rows[0]))
-	for i := range tbl.rows {
-		for k := range tbl.rows[i] {
-			// header row? column width may exceed max width
-			if i < tbl.numHeaderRows {
-				if headerWidth := runeWidth(tbl.rows[i][k]); headerWidth > ret[k] {
-					ret[k] = headerWidth
+	return tbl.resizeColWidthsInto(nil)
+}
+
+// like resizeColWidths, but reuses the memory in `ret` if it has enough capacity
+func (tbl *Table) resizeColWidthsInto(ret []int) []int {
+	numCols := len(tbl.rows.at(0).cells)
+	if cap(ret) < numCols {
+		ret = make([]int, numCols)
+	}
+	ret = ret[:numCols]
+	for k := range ret {
+		ret[k] = 0
+	}
+	for i := 0; i < tbl.rows.len(); i++ {
+		row := tbl.rows.at(i).cells
+		for k := range row {
+			cellWidth := blockWidth(row[k])
+			// header row? column width may exceed max width, unless header cells wrap or truncate
+			if i < tbl.numHeaderRows && !tbl.limitHeaders() {
+				if cellWidth > ret[k] {
+					ret[k] = cellWidth
 				}
 			} else {
 				// not header row? column width may not exceed max width
 			}
-			cellWidth := runeWidth(tbl.rows[i][k])
-			if cellWidth > maxColWidth {
-				cellWidth = maxColWidth
+			if max := tbl.maxColumnWidth(); cellWidth > max && tbl.overflow(k) != OverflowNone {
+				cellWidth = max
 			}
 			if cellWidth > ret[k] {
 				ret[k] = cellWidth
 			}
 		}
 	}
+	for k := range ret {
+		if minWidth := tbl.minColumnWidth(k); minWidth > ret[k] {
+			ret[k] = minWidth
+		}
+	}
 	return ret
 }
 
-// repeat `s`, `n` times
-func repeat(s string, n int) string {
-	var ret string
-	for i := 0; i < n; i++ {
-		ret += s
+// checkFit returns an error if any cell is wider than its column in `colWidths`.
+func (tbl *Table) checkFit(colWidths []int) error {
+	for i := 0; i < tbl.rows.len(); i++ {
+		for k, cell := range tbl.rows.at(i).cells {
+			if w := blockWidth(cell); w > colWidths[k] {
+				return fmt.Errorf("strict widths: row %d, column %d: cell width %d exceeds column width %d", i, k, w, colWidths[k])
+			}
+		}
 	}
-	return ret
+	return nil
+}
+
+// rowLayout describes the edges and padding around the columns in a rendered line.
+type rowLayout struct {
+	// each column is widened by its padding (nil: 1-space padding on either side)
+	paddings []cellPadding
+	// the column at index labelEdge is followed by a label edge (-1: no label edge)
+	labelEdge int
+	// omit the leftmost and rightmost edges
+	noLeftEdge, noRightEdge bool
+	// overrides the filler and edge symbols of the header border (nil: use the defaults)
+	headerDivider *headerDivider
+	// columns are separated by this many spaces, without any dividing rows (0: columns are separated by edges)
+	spacing int
 }
 
 // [3,3] -> +---+---+
-func stringifyDividingRow(colWidths []int, numLabelLevels int, header bool) string {
+func stringifyDividingRow(colWidths []int, spec rowLayout, row dividingRow) string {
+	if spec.spacing > 0 {
+		return ""
+	}
+	// dividing rows are stringified once per render and then reused for every table edge and header divider
 	// set dividing symbol values (default: border)
 	edge := borderEdge
-	labelEdge := borderLabelEdge
+	labelEdgeSymbol := borderLabelEdge
 	filler := borderFiller
-	if header {
+	if row == dividingHeader {
 		edge = headerEdge
-		labelEdge = headerLabelEdge
+		labelEdgeSymbol = headerLabelEdge
 		filler = headerFiller
 	}
+	left, cross, right := edge, edge, edge
+	if symbol := junctions[row][0]; symbol != "" {
+		left = symbol
+	}
+	if symbol := junctions[row][1]; symbol != "" {
+		cross = symbol
+	}
+	if symbol := junctions[row][2]; symbol != "" {
+		right = symbol
+	}
+	if row == dividingHeader && spec.headerDivider != nil {
+		filler = spec.headerDivider.filler
+		if symbol := spec.headerDivider.edge; symbol != "" {
+			left, cross, right = symbol, symbol, symbol
+		}
+	}
 
 	ret := strings.Builder{}
 	// leftmost edge
-	ret.WriteString(edge)
+	if !spec.noLeftEdge {
+		ret.WriteString(left)
+	}
 
 	for k := range colWidths {
-		// sets the number of filler symbols per column, plus a 1-space buffer on either end
-		ret.WriteString(repeat(filler, 1+colWidths[k]+1))
-		if k == numLabelLevels-1 {
-			ret.WriteString(labelEdge)
-		} else {
-			ret.WriteString(edge)
+		// sets the number of filler symbols per column, plus its padding on either end
+		pad := defaultPadding
+		if spec.paddings != nil {
+			pad = spec.paddings[k]
+		}
+		ret.WriteString(strings.Repeat(filler, pad.left+colWidths[k]+pad.right))
+		switch {
+		case k == len(colWidths)-1 && spec.noRightEdge:
+		case k == spec.labelEdge:
+			ret.WriteString(labelEdgeSymbol)
+		case k == len(colWidths)-1:
+			ret.WriteString(right)
+		default:
+			ret.WriteString(cross)
 		}
 	}
-	return fmt.Sprintln(ret.String())
+	ret.WriteByte('\n')
+	return ret.String()
 }
 
 func exceedsMaxWidth(s string, maxWidth int) bool {
@@ -236,104 +1154,232 @@ func truncate(s string, maxWidth int) string {
 	if !exceedsMaxWidth(s, maxWidth) {
 		return s
 	}
-	r := []rune(s)
-	return string(r[:maxWidth-3]) + "..."
+	return layout.TruncateRunes([]rune(s), maxWidth)
 }
 
 // try to wrap at a space.
 // if wrapping mid-word, insert hyphen
 func wrap(s string, maxWidth int) (firstLine string, remainder string) {
-	// no split required?
-	if !exceedsMaxWidth(s, maxWidth) {
-		return s, ""
-	}
-
-	r := []rune(s)
-	// last letter is whitespace? truncate last whitespace
-	if unicode.IsSpace(r[maxWidth-1]) {
-		return string(r[:maxWidth-1]), string(r[maxWidth:])
-	}
-	// penultimate letter is space?
-	if unicode.IsSpace(r[maxWidth-2]) {
-		// single-character word? retain on line and truncate the next whitespace
-		if unicode.IsSpace(r[maxWidth]) {
-			return string(r[:maxWidth]), strings.TrimLeftFunc(string(r[maxWidth:]), unicode.IsSpace)
-		}
-		// truncate last whitesapce
-		return string(r[:maxWidth-2]), string(r[maxWidth-1:])
-	}
-	// multi-character word? insert "-" at end
-	ret := make([]rune, maxWidth-1)
-	copy(ret, r[:maxWidth-1])
-	ret = append(ret, '-')
-	return string(ret), string(r[maxWidth-1:])
+	return layout.WrapLine(s, maxWidth)
 }
 
 // handle overly-wide columns by either wrapping or truncating.
 // if wrapping, writes multiple lines per row.
-func (tbl *Table) stringifyContentRow(colWidths []int, content []string, header bool) string {
-	// loop until there are no remaining wrapped lines to print
+// if `styles` is not nil, styles the text on every line of each cell.
+func (tbl *Table) stringifyContentRow(colWidths []int, content []string, styles []Style, header bool) string {
 	ret := strings.Builder{}
-	for {
+	tbl.writeContentRow(&ret, colWidths, content, styles, header)
+	return ret.String()
+}
+
+// like stringifyContentRow, but writes the row into `ret`.
+// overwrites `content` with the remainder of each wrapped cell as it goes.
+func (tbl *Table) writeContentRow(ret stringWriter, colWidths []int, content []string, styles []Style, header bool) {
+	labelEdge := tbl.labelEdge(len(colWidths))
+	noLeftEdge := tbl.suppressed(SuppressLeftBorder) || tbl.spacing > 0
+	noRightEdge := tbl.suppressed(SuppressRightBorder) || tbl.spacing > 0
+	edge, labelEdgeSymbol := tbl.separators()
+	// rows that are entirely ASCII can be truncated and wrapped without decoding runes
+	ascii := true
+	for k := range content {
+		if !layout.IsASCII(content[k]) {
+			ascii = false
+			break
+		}
+	}
+	// continued[k] is true if the current line of cell k continues a line wrapped on the previous line
+	var continued []bool
+	var markerWidth int
+	if (tbl.continuationMarker != "" || tbl.hasContinuationAlignment) && !header {
+		continued = make([]bool, len(colWidths))
+		markerWidth = layout.Width(tbl.continuationMarker)
+	}
+	// loop until there are no remaining wrapped lines to print
+	for line := 0; ; line++ {
 		var moreWrappedLines bool
+		// on the last line allowed per cell, any remaining text is replaced by an ellipsis
+		lastLine := tbl.maxLinesPerCell > 0 && line == tbl.maxLinesPerCell-1
 
 		// leftmost edge
-		ret.WriteString(contentEdge)
+		if !noLeftEdge {
+			ret.WriteString(edge)
+		}
 
 		// iterate over columns
 		for k := range colWidths {
 			var remainder string
+			// a line break ends the current line of a multi-line cell, and the rest of the cell continues on the next line
+			lineBreak := strings.IndexByte(content[k], '\n')
+			if lineBreak >= 0 {
+				content[k], remainder = content[k][:lineBreak], content[k][lineBreak+1:]
+				moreWrappedLines = true
+			}
+			// continuation lines are narrowed to make room for the continuation marker
+			width := colWidths[k]
+			var isContinuation bool
+			if continued != nil {
+				isContinuation = continued[k] && markerWidth < width
+				if isContinuation {
+					width -= markerWidth
+				}
+			}
+			// measure each line of a cell once, and decode its runes only if it is overly wide
+			textWidth := runeWidth(content[k])
 			// handling overly-wide columns
-			if exceedsMaxWidth(content[k], colWidths[k]) {
-				// truncate?
-				if tbl.truncateCells {
-					content[k] = truncate(content[k], colWidths[k])
+			var softWrapped bool
+			if textWidth > width {
+				overflow := tbl.overflow(k)
+				if header && tbl.limitHeaders() {
+					overflow = tbl.headerOverflow
+				}
+				if overflow == OverflowTruncate {
+					content[k] = truncateCell(content[k], width, ascii)
+					textWidth = width
+				} else if ascii && strings.IndexByte(content[k], '\x1b') < 0 {
+					// wrap without decoding runes
+					firstLine, wrapped := layout.WrapASCII(content[k], width)
+					softWrapped = wrapped != ""
+					if wrapped != "" {
+						moreWrappedLines = true
+						if lineBreak >= 0 {
+							wrapped += "\n" + remainder
+						}
+						remainder = wrapped
+					}
+					content[k] = firstLine
+					textWidth = len(firstLine)
+				} else if strings.IndexByte(content[k], '\x1b') >= 0 {
+					// wrap without cutting or counting embedded control sequences (e.g., highlights)
+					firstLine, wrapped := layout.WrapANSI(content[k], width)
+					softWrapped = wrapped != ""
+					if wrapped != "" {
+						moreWrappedLines = true
+						if lineBreak >= 0 {
+							wrapped += "\n" + remainder
+						}
+						remainder = wrapped
+					}
+					content[k] = firstLine
+					textWidth = runeWidth(firstLine)
 				} else {
+					r := []rune(content[k])
 					// wrap?
-					var firstLine string
-					firstLine, remainder = wrap(content[k], colWidths[k])
-					if remainder != "" {
+					firstLine, wrapped := layout.WrapRunes(r, width)
+					softWrapped = wrapped != ""
+					if wrapped != "" {
 						moreWrappedLines = true
+						if lineBreak >= 0 {
+							wrapped += "\n" + remainder
+						}
+						remainder = wrapped
 					}
-					content[k] = firstLine
+					content[k] = string(firstLine)
+					textWidth = len(firstLine)
 				}
 			}
-			// Center the content in header rows. Use Table alignment (default: Center) for non-header rows.
-			alignment := tbl.alignment
+			if continued != nil {
+				continued[k] = softWrapped
+			}
+			if lastLine && remainder != "" {
+				content[k] = layout.TruncateANSI(content[k]+"...", width)
+				textWidth = runeWidth(content[k])
+				remainder = ""
+			}
+			if isContinuation && markerWidth > 0 {
+				content[k] = tbl.continuationMarker + content[k]
+				textWidth += markerWidth
+			}
+			// Center the content in header rows. Use column or Table alignment (default: Center) for non-header rows.
+			alignment := tbl.columnAlignment(k)
 			if header && tbl.autoCenterHeaders {
 				alignment = AlignCenter
+			} else if isContinuation && tbl.hasContinuationAlignment {
+				alignment = tbl.continuationAlignment
 			}
 			// align text content and add to string
-			ret.WriteString(alignString(content[k], colWidths[k], alignment))
+			var style Style
+			if styles != nil {
+				style = styles[k]
+			}
+			if tbl.plainLinks {
+				style.link = ""
+			}
+			writeAligned(ret, content[k], textWidth, colWidths[k], alignment, style, tbl.columnPadding(k))
 			// add separator after column, including at rightmost edge
-			if k == tbl.numLabelLevels-1 {
-				ret.WriteString(contentLabelEdge)
-			} else {
-				ret.WriteString(contentEdge)
+			switch {
+			case k == len(colWidths)-1 && noRightEdge:
+				// omit the rightmost edge
+			case k == labelEdge:
+				ret.WriteString(labelEdgeSymbol)
+			default:
+				ret.WriteString(edge)
 			}
 			// overwrite content with either wrappedLine or empty cell
 			content[k] = remainder
 		}
 		// start a new line if text is wrapped, otherwise end the loop
-		if moreWrappedLines {
+		if moreWrappedLines && !lastLine {
 			ret.WriteString("\n")
 		} else {
 			break
 		}
 	}
 
-	return fmt.Sprintln(ret.String())
+	ret.WriteString("\n")
+}
+
+// truncateCell shortens the overly wide line `s` to `width`, without decoding runes if `ascii` is true.
+// Embedded ANSI control sequences are never cut.
+func truncateCell(s string, width int, ascii bool) string {
+	switch {
+	case strings.IndexByte(s, '\x1b') >= 0:
+		return layout.TruncateANSI(s, width)
+	case ascii:
+		return layout.TruncateASCII(s, width)
+	default:
+		return layout.TruncateRunes([]rune(s), width)
+	}
 }
 
 // expects string to already be truncated or wrapped.
 // adds a 1-space buffer on either side
 func alignString(s string, width int, alignment Alignment) string {
-	if alignment == AlignLeft {
-		return fmt.Sprintf(" %-*s ", width, s)
+	return alignStyledString(s, width, alignment, Style{})
+}
+
+// like alignString, but applies `style` to the text only, and not to the padding around it
+func alignStyledString(s string, width int, alignment Alignment, style Style) string {
+	return alignMeasuredString(s, runeWidth(s), width, alignment, style)
+}
+
+// like alignStyledString, but expects `textWidth` to already be measured
+func alignMeasuredString(s string, textWidth int, width int, alignment Alignment, style Style) string {
+	ret := strings.Builder{}
+	writeAligned(&ret, s, textWidth, width, alignment, style, defaultPadding)
+	return ret.String()
+}
+
+// writes the aligned string into `b` directly to avoid allocating intermediate strings.
+// a background color fills the padding as well as the text, so that the entire cell is colored.
+func writeAligned(b stringWriter, s string, textWidth int, width int, alignment Alignment, style Style, pad cellPadding) {
+	left, right := layout.Padding(textWidth, width, layout.Alignment(alignment))
+	left += pad.left
+	right += pad.right
+	if style.Background != ColorDefault {
+		background := Style{Background: style.Background}
+		b.WriteString(background.apply(strings.Repeat(" ", left)))
+		b.WriteString(style.apply(s))
+		b.WriteString(background.apply(strings.Repeat(" ", right)))
+		return
 	}
-	if alignment == AlignRight {
-		return fmt.Sprintf(" %*s ", width, s)
+	b.Grow(left + len(s) + right)
+	writeSpaces(b, left)
+	b.WriteString(style.apply(s))
+	writeSpaces(b, right)
+}
+
+func writeSpaces(b stringWriter, n int) {
+	for i := 0; i < n; i++ {
+		b.WriteByte(' ')
 	}
-	rightJustified := fmt.Sprintf("%*s", (width+runeWidth(s))/2, s)
-	return fmt.Sprintf(" %-*s ", width, rightJustified)
 }
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"reflect"
+	"runtime"
+	"strings"
 	"testing"
 )
 
@@ -27,6 +30,7 @@ func TestTable_render(t *testing.T) {
 		autoCenterHeaders bool
 		autoMerge         bool
 		truncateCells     bool
+		autoIndex         bool
 	}
 	tests := []struct {
 		name    string
@@ -142,6 +146,22 @@ func TestTable_render(t *testing.T) {
 				"+-------++------+\n",
 			false,
 		},
+		{"auto index - header",
+			fields{
+				rows:              [][]string{{"foo", "bar"}, {"corge", "quux"}, {"baz", "fred"}},
+				alignment:         AlignLeft,
+				autoCenterHeaders: true,
+				numHeaderRows:     1,
+				autoIndex:         true},
+			"" +
+				"+---++-------+------+\n" +
+				"|   ||  foo  | bar  |\n" +
+				"|---||-------|------|\n" +
+				"| 1 || corge | quux |\n" +
+				"| 2 || baz   | fred |\n" +
+				"+---++-------+------+\n",
+			false,
+		},
 		{"fail - no data",
 			fields{
 				rows:           [][]string{},
@@ -156,13 +176,14 @@ func TestTable_render(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tbl := &Table{
 				w:                 tt.fields.w,
-				rows:              tt.fields.rows,
+				rows:              newRowStore(tt.fields.rows),
 				alignment:         tt.fields.alignment,
 				numHeaderRows:     tt.fields.numHeaderRows,
 				numLabelLevels:    tt.fields.numLabelLevels,
 				autoCenterHeaders: tt.fields.autoCenterHeaders,
 				autoMerge:         tt.fields.autoMerge,
 				truncateCells:     tt.fields.truncateCells,
+				autoIndex:         tt.fields.autoIndex,
 			}
 			got, err := tbl.render()
 			if (err != nil) != tt.wantErr {
@@ -176,6 +197,175 @@ func TestTable_render(t *testing.T) {
 	}
 }
 
+func TestTable_render_repeated(t *testing.T) {
+	tbl := NewTable(nil)
+	tbl.AppendRows([][]string{{"foo", "bar"}, {"foo", "much too long to fit on one line"}, {"baz", "quux"}})
+	tbl.MergeRepeats()
+	want := "" +
+		"+-----+--------------------------------+\n" +
+		"| foo |              bar               |\n" +
+		"|     | much too long to fit on one l- |\n" +
+		"|     |              ine               |\n" +
+		"| baz |              quux              |\n" +
+		"+-----+--------------------------------+\n"
+	for i := 0; i < 3; i++ {
+		got, err := tbl.render()
+		if err != nil {
+			t.Fatalf("Table.render() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("Table.render() #%d = %v, want %v", i, got, want)
+		}
+	}
+	wantRows := [][]string{{"foo", "bar"}, {"foo", "much too long to fit on one line"}, {"baz", "quux"}}
+	if !reflect.DeepEqual(tbl.rows.all(), wantRows) {
+		t.Errorf("Table.render() changed original rows -> %v, want %v", tbl.rows.all(), wantRows)
+	}
+}
+
+func TestTable_render_suppress(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts Suppression
+		want  string
+	}{
+		{"nothing", 0, "" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"|-----|\n" +
+			"| bar |\n" +
+			"+-----+\n"},
+		{"headers", SuppressHeaders, "" +
+			"+-----+\n" +
+			"| bar |\n" +
+			"+-----+\n"},
+		{"top border", SuppressTopBorder, "" +
+			"| foo |\n" +
+			"|-----|\n" +
+			"| bar |\n" +
+			"+-----+\n"},
+		{"bottom border", SuppressBottomBorder, "" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"|-----|\n" +
+			"| bar |\n"},
+		{"all but body", SuppressAllButBody, "" +
+			"| bar |\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo"}, [][]string{{"bar"}})
+			tbl.Suppress(tt.parts)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_Dimensions(t *testing.T) {
+	tests := []struct {
+		name       string
+		tbl        *Table
+		wantWidth  int
+		wantHeight int
+		wantErr    bool
+	}{
+		{"pass", newTestTable([]string{"foo", "bar"}, [][]string{{"baz", "much too long to fit on one line"}}), 40, 6, false},
+		{"fail - empty", NewTable(nil), 0, 0, true},
+	}
+	tests[0].tbl.SetHeaderStyle(Style{Bold: true})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotWidth, gotHeight, err := tt.tbl.Dimensions()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Table.Dimensions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotWidth != tt.wantWidth || gotHeight != tt.wantHeight {
+				t.Errorf("Table.Dimensions() = %v, %v, want %v, %v", gotWidth, gotHeight, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func Test_estimateSize(t *testing.T) {
+	type args struct {
+		lineSize      int
+		numRows       int
+		numHeaderRows int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{"no header", args{10, 3, 0}, 50},
+		{"header", args{10, 3, 1}, 60},
+		{"header only", args{10, 3, 3}, 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := estimateSize(tt.args.lineSize, tt.args.numRows, tt.args.numHeaderRows); got != tt.want {
+				t.Errorf("estimateSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func benchmarkTable(numRows int) *Table {
+	tbl := NewTable(nil)
+	tbl.AppendHeaderRow([]string{"id", "name", "description"})
+	for i := 0; i < numRows; i++ {
+		tbl.AppendRow([]string{fmt.Sprint(i), "foo bar", "much too long to fit on a single line of this table"})
+	}
+	return tbl
+}
+
+// BenchmarkTable_render_overflow compares wrapping and truncating rows that are entirely ASCII
+// with rows that contain a non-ASCII rune, which must be decoded.
+func BenchmarkTable_render_overflow(b *testing.B) {
+	for _, text := range []string{"ascii", "non-ascii"} {
+		for _, truncate := range []bool{false, true} {
+			b.Run(fmt.Sprintf("%s/truncate=%v", text, truncate), func(b *testing.B) {
+				tbl := NewTable(nil)
+				tbl.AppendHeaderRow([]string{"id", "description"})
+				description := "much too long to fit on a single line of this table, so it must wrap several times"
+				if text == "non-ascii" {
+					description = "é" + description
+				}
+				for i := 0; i < 10000; i++ {
+					tbl.AppendRow([]string{fmt.Sprint(i), description})
+				}
+				if truncate {
+					tbl.TruncateWideCells()
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				for n := 0; n < b.N; n++ {
+					tbl.render()
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkTable_render(b *testing.B) {
+	for _, numRows := range []int{100, 10000, 100000} {
+		b.Run(fmt.Sprint(numRows), func(b *testing.B) {
+			tbl := benchmarkTable(numRows)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				tbl.render()
+			}
+		})
+	}
+}
+
 type testBadWriter string
 
 func (w testBadWriter) Write([]byte) (int, error) {
@@ -232,7 +422,7 @@ func TestTable_Render(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tbl := &Table{
 				w:              tt.fields.w,
-				rows:           tt.fields.rows,
+				rows:           newRowStore(tt.fields.rows),
 				numHeaderRows:  tt.fields.numHeaderRows,
 				numLabelLevels: tt.fields.numLabelLevels,
 				autoMerge:      tt.fields.autoMerge,
@@ -247,6 +437,119 @@ func TestTable_Render(t *testing.T) {
 	}
 }
 
+func TestTable_RenderTo(t *testing.T) {
+	var tableWriter, other bytes.Buffer
+	tbl := NewTable(&tableWriter)
+	tbl.AppendRow([]string{"foo"})
+	if err := tbl.RenderTo(&other); err != nil {
+		t.Fatalf("Table.RenderTo() error = %v", err)
+	}
+	if want := "+-----+\n| foo |\n+-----+\n"; other.String() != want {
+		t.Errorf("Table.RenderTo() -> %v, want %v", other.String(), want)
+	}
+	if tableWriter.Len() != 0 {
+		t.Errorf("Table.RenderTo() wrote to the table's writer")
+	}
+	if err := tbl.RenderTo(testBadWriter("")); err == nil {
+		t.Errorf("Table.RenderTo() with bad writer error = nil, want error")
+	}
+	if err := NewTable(&other).RenderTo(&other); err == nil {
+		t.Errorf("Table.RenderTo() on empty table error = nil, want error")
+	}
+}
+
+func TestTable_RenderRange(t *testing.T) {
+	var buf bytes.Buffer
+	tbl := NewTable(&buf)
+	tbl.AppendHeaderRow([]string{"n"})
+	tbl.AppendRows([][]string{{"a"}, {"bb"}})
+	tbl.AppendDivider()
+	tbl.AppendRows([][]string{{"ccc"}, {"dddd"}})
+	tbl.EnableAutoIndex()
+	if err := tbl.RenderRange(1, 3); err != nil {
+		t.Fatalf("Table.RenderRange() error = %v", err)
+	}
+	want := "" +
+		"+---++-----+\n" +
+		"|   ||  n  |\n" +
+		"|---||-----|\n" +
+		"| 2 || bb  |\n" +
+		"+---++-----+\n" +
+		"| 3 || ccc |\n" +
+		"+---++-----+\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Table.RenderRange() -> %v, want %v", got, want)
+	}
+	if tbl.rows.len() != 5 || len(tbl.dividers) != 1 {
+		t.Errorf("Table.RenderRange() changed the stored rows or dividers")
+	}
+
+	tests := []struct {
+		name       string
+		start, end int
+		wantErr    bool
+	}{
+		{"empty range", 2, 2, false},
+		{"negative start", -1, 2, true},
+		{"end out of range", 0, 5, true},
+		{"start after end", 3, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tbl.RenderRange(tt.start, tt.end); (err != nil) != tt.wantErr {
+				t.Errorf("Table.RenderRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	if err := NewTable(testBadWriter("")).RenderRange(0, 0); err == nil {
+		t.Errorf("Table.RenderRange() on empty table error = nil, want error")
+	}
+}
+
+func TestTable_RenderWindow(t *testing.T) {
+	var buf bytes.Buffer
+	tbl := NewTable(&buf)
+	tbl.AppendHeaderRow([]string{"id", "a", "b", "c"})
+	tbl.AppendRows([][]string{{"x", "1", "22", "333"}, {"y", "4", "55", "666"}})
+	tbl.SetColumnAlignment(3, AlignRight)
+	tbl.AddFooterAggregate(1, AggSum)
+	if err := tbl.RenderWindow(2, 3, 1); err != nil {
+		t.Fatalf("Table.RenderWindow() error = %v", err)
+	}
+	want := "" +
+		"+----++----+-----+\n" +
+		"| id || b  |  c  |\n" +
+		"|----||----|-----|\n" +
+		"| x  || 22 | 333 |\n" +
+		"| y  || 55 | 666 |\n" +
+		"+----++----+-----+\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Table.RenderWindow() -> %v, want %v", got, want)
+	}
+	if tbl.NumColumns() != 4 || tbl.numLabelLevels != 0 || tbl.column(3).alignment != AlignRight {
+		t.Errorf("Table.RenderWindow() changed the stored table")
+	}
+
+	tests := []struct {
+		name                          string
+		firstCol, lastCol, frozenCols int
+		wantErr                       bool
+	}{
+		{"frozen column in range", 0, 1, 1, false},
+		{"no frozen columns", 3, 3, 0, false},
+		{"first after last", 2, 1, 0, true},
+		{"last out of range", 1, 4, 0, true},
+		{"too many frozen", 1, 2, 5, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tbl.RenderWindow(tt.firstCol, tt.lastCol, tt.frozenCols); (err != nil) != tt.wantErr {
+				t.Errorf("Table.RenderWindow() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestTable_resizeColWidths(t *testing.T) {
 	type fields struct {
 		w              io.Writer
@@ -287,7 +590,7 @@ func TestTable_resizeColWidths(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tbl := &Table{
 				w:              tt.fields.w,
-				rows:           tt.fields.rows,
+				rows:           newRowStore(tt.fields.rows),
 				numHeaderRows:  tt.fields.numHeaderRows,
 				numLabelLevels: tt.fields.numLabelLevels,
 				autoMerge:      tt.fields.autoMerge,
@@ -337,6 +640,32 @@ func Test_alignString(t *testing.T) {
 	}
 }
 
+func Test_alignStyledString(t *testing.T) {
+	type args struct {
+		s         string
+		maxWidth  int
+		alignment Alignment
+		style     Style
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"no style", args{"foo", 5, AlignLeft, Style{}}, " foo   "},
+		{"text style excludes padding", args{"foo", 5, AlignLeft, Style{Bold: true}}, " \x1b[1mfoo\x1b[0m   "},
+		{"background includes padding", args{"foo", 5, AlignRight, Style{Background: ColorRed}},
+			"\x1b[41m   \x1b[0m\x1b[41mfoo\x1b[0m\x1b[41m \x1b[0m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := alignStyledString(tt.args.s, tt.args.maxWidth, tt.args.alignment, tt.args.style); got != tt.want {
+				t.Errorf("alignStyledString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_truncate(t *testing.T) {
 	type args struct {
 		s        string
@@ -350,6 +679,9 @@ func Test_truncate(t *testing.T) {
 		{"no truncate required", args{"much too long", 13}, "much too long"},
 		{"ASCII", args{"much too long indeed", 10}, "much to..."},
 		{"non-ASCII", args{"å¬ßø too long", 10}, "å¬ßø to..."},
+		{"width 3", args{"much too long", 3}, "mu…"},
+		{"width 1", args{"much too long", 1}, "…"},
+		{"width 0", args{"much too long", 0}, ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -394,9 +726,9 @@ func Test_wrap(t *testing.T) {
 
 func Test_stringifyDividingRow(t *testing.T) {
 	type args struct {
-		columnWidths   []int
-		numLabelLevels int
-		header         bool
+		columnWidths []int
+		labelEdge    int
+		row          dividingRow
 	}
 	tests := []struct {
 		name string
@@ -405,28 +737,28 @@ func Test_stringifyDividingRow(t *testing.T) {
 	}{
 		{
 			"no label levels - not header",
-			args{[]int{1, 3, 1}, 0, false},
+			args{[]int{1, 3, 1}, -1, dividingTop},
 			"+---+-----+---+\n",
 		},
 		{
 			"no label levels - header",
-			args{[]int{1, 3, 1}, 0, true},
+			args{[]int{1, 3, 1}, -1, dividingHeader},
 			"|---|-----|---|\n",
 		},
 		{
 			"1 label level - not header",
-			args{[]int{1, 3, 1}, 1, false},
+			args{[]int{1, 3, 1}, 0, dividingTop},
 			"+---++-----+---+\n",
 		},
 		{
 			"2 label levels - not header",
-			args{[]int{1, 3, 1}, 2, false},
+			args{[]int{1, 3, 1}, 1, dividingTop},
 			"+---+-----++---+\n",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := stringifyDividingRow(tt.args.columnWidths, tt.args.numLabelLevels, tt.args.header); got != tt.want {
+			if got := stringifyDividingRow(tt.args.columnWidths, rowLayout{labelEdge: tt.args.labelEdge}, tt.args.row); got != tt.want {
 				t.Errorf("stringifyDividingRow() = %v, want %v", got, tt.want)
 			}
 		})
@@ -446,6 +778,7 @@ func TestTable_stringifyContentRow(t *testing.T) {
 	type args struct {
 		colWidths []int
 		content   []string
+		styles    []Style
 		isHeader  bool
 	}
 	tests := []struct {
@@ -462,7 +795,7 @@ func TestTable_stringifyContentRow(t *testing.T) {
 				numLabelLevels: 0,
 				truncateCells:  false},
 			args{
-				[]int{5, 5}, []string{"foo", "bar"}, false,
+				[]int{5, 5}, []string{"foo", "bar"}, nil, false,
 			},
 			"| foo   | bar   |\n",
 		},
@@ -474,7 +807,7 @@ func TestTable_stringifyContentRow(t *testing.T) {
 				autoCenterHeaders: true,
 				truncateCells:     false},
 			args{
-				[]int{5, 5}, []string{"foo", "bar"}, true,
+				[]int{5, 5}, []string{"foo", "bar"}, nil, true,
 			},
 			"|  foo  |  bar  |\n",
 		},
@@ -485,7 +818,7 @@ func TestTable_stringifyContentRow(t *testing.T) {
 				numLabelLevels: 0,
 				truncateCells:  false},
 			args{
-				[]int{3, 2}, []string{"foo", "bar"}, false,
+				[]int{3, 2}, []string{"foo", "bar"}, nil, false,
 			},
 			"" +
 				"| foo | b- |\n" +
@@ -498,7 +831,7 @@ func TestTable_stringifyContentRow(t *testing.T) {
 				numLabelLevels: 0,
 				truncateCells:  true},
 			args{
-				[]int{3, 4}, []string{"foo", "corge"}, false,
+				[]int{3, 4}, []string{"foo", "corge"}, nil, false,
 			},
 			"| foo | c... |\n",
 		},
@@ -510,10 +843,23 @@ func TestTable_stringifyContentRow(t *testing.T) {
 				autoMerge:      false,
 				truncateCells:  false},
 			args{
-				[]int{3, 3}, []string{"foo", "bar"}, false,
+				[]int{3, 3}, []string{"foo", "bar"}, nil, false,
 			},
 			"| foo || bar |\n",
 		},
+		{"styled - style applies to text on each line but not padding",
+			fields{
+				rows:           [][]string{{"foo", "bar"}, {"baz", "qux"}},
+				alignment:      AlignLeft,
+				numLabelLevels: 0,
+				truncateCells:  false},
+			args{
+				[]int{4, 2}, []string{"foo", "bar"}, []Style{{Foreground: ColorRed}, {}}, false,
+			},
+			"" +
+				"| \x1b[31mfoo\x1b[0m  | b- |\n" +
+				"|      | ar |\n",
+		},
 		{"2 label level - all rows 1 line - not header",
 			fields{
 				rows:           [][]string{{"foo", "bar", "baz"}, {"qux", "corge", "fred"}},
@@ -522,7 +868,7 @@ func TestTable_stringifyContentRow(t *testing.T) {
 				autoMerge:      false,
 				truncateCells:  false},
 			args{
-				[]int{3, 5, 4}, []string{"foo", "bar", "baz"}, false,
+				[]int{3, 5, 4}, []string{"foo", "bar", "baz"}, nil, false,
 			},
 			"| foo |  bar  || baz  |\n",
 		},
@@ -531,14 +877,14 @@ func TestTable_stringifyContentRow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tbl := &Table{
 				w:                 tt.fields.w,
-				rows:              tt.fields.rows,
+				rows:              newRowStore(tt.fields.rows),
 				alignment:         tt.fields.alignment,
 				numLabelLevels:    tt.fields.numLabelLevels,
 				autoCenterHeaders: tt.fields.autoCenterHeaders,
 				autoMerge:         tt.fields.autoMerge,
 				truncateCells:     tt.fields.truncateCells,
 			}
-			if gotRet := tbl.stringifyContentRow(tt.args.colWidths, tt.args.content, tt.args.isHeader); gotRet != tt.wantRet {
+			if gotRet := tbl.stringifyContentRow(tt.args.colWidths, tt.args.content, tt.args.styles, tt.args.isHeader); gotRet != tt.wantRet {
 				t.Errorf("Table.stringifyContentRow() = %v, want %v", gotRet, tt.wantRet)
 			}
 		})
@@ -549,6 +895,7 @@ func Test_autoMergeRows(t *testing.T) {
 	type args struct {
 		priorRow   []string
 		currentRow []string
+		nested     bool
 	}
 	tests := []struct {
 		name        string
@@ -557,13 +904,21 @@ func Test_autoMergeRows(t *testing.T) {
 		wantCurrent []string
 	}{
 		{name: "pass",
-			args:        args{[]string{"foo", "bar"}, []string{"baz", "bar"}},
+			args:        args{[]string{"foo", "bar"}, []string{"baz", "bar"}, false},
 			wantPrior:   []string{"baz", "bar"},
 			wantCurrent: []string{"baz", ""}},
+		{name: "nested",
+			args:        args{[]string{"foo", "bar"}, []string{"baz", "bar"}, true},
+			wantPrior:   []string{"baz", "bar"},
+			wantCurrent: []string{"baz", "bar"}},
+		{name: "nested unchanged",
+			args:        args{[]string{"foo", "bar"}, []string{"foo", "bar"}, true},
+			wantPrior:   []string{"foo", "bar"},
+			wantCurrent: []string{"", ""}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			autoMergeRows(tt.args.priorRow, tt.args.currentRow)
+			autoMergeRows(tt.args.priorRow, tt.args.currentRow, tt.args.nested)
 			if !reflect.DeepEqual(tt.args.priorRow, tt.wantPrior) {
 				t.Errorf("autoMergeRows() priorRow -> %v, want %v", tt.args.priorRow, tt.wantPrior)
 			}
@@ -584,7 +939,6 @@ func TestNewTable(t *testing.T) {
 			&Table{
 				// all other fields initialize at their zero-value
 				w:                 &bytes.Buffer{},
-				rows:              [][]string{},
 				autoCenterHeaders: true,
 			},
 			""},
@@ -602,6 +956,39 @@ func TestNewTable(t *testing.T) {
 	}
 }
 
+func TestTable_getters(t *testing.T) {
+	tbl := newTestTable([]string{"name", "n"}, [][]string{{"foo", "1"}, {"bar", "2"}})
+	if got := tbl.NumRows(); got != 2 {
+		t.Errorf("Table.NumRows() -> %v, want 2", got)
+	}
+	if got := tbl.NumHeaderRows(); got != 1 {
+		t.Errorf("Table.NumHeaderRows() -> %v, want 1", got)
+	}
+	if got := tbl.NumColumns(); got != 2 {
+		t.Errorf("Table.NumColumns() -> %v, want 2", got)
+	}
+	if got, want := tbl.Row(1), []string{"bar", "2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.Row(1) -> %v, want %v", got, want)
+	}
+	if got := tbl.Row(2); got != nil {
+		t.Errorf("Table.Row(2) -> %v, want nil", got)
+	}
+	if got, want := tbl.Rows(), [][]string{{"foo", "1"}, {"bar", "2"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.Rows() -> %v, want %v", got, want)
+	}
+	if got, want := tbl.HeaderRows(), [][]string{{"name", "n"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.HeaderRows() -> %v, want %v", got, want)
+	}
+	tbl.Rows()[0][0] = "changed"
+	tbl.Row(0)[0] = "changed"
+	if got := tbl.rows.at(1).cells[0]; got != "foo" {
+		t.Errorf("Table.Rows() shares cells with the table")
+	}
+	if got := NewTable(nil).NumColumns(); got != 0 {
+		t.Errorf("Table.NumColumns() on empty table -> %v, want 0", got)
+	}
+}
+
 func TestTable_sameShape(t *testing.T) {
 	type fields struct {
 		w              io.Writer
@@ -641,7 +1028,7 @@ func TestTable_sameShape(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tbl := &Table{
 				w:              tt.fields.w,
-				rows:           tt.fields.rows,
+				rows:           newRowStore(tt.fields.rows),
 				alignment:      tt.fields.alignment,
 				numHeaderRows:  tt.fields.numHeaderRows,
 				numLabelLevels: tt.fields.numLabelLevels,
@@ -726,7 +1113,7 @@ func TestTable_AppendHeaderRow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tbl := &Table{
 				w:              tt.fields.w,
-				rows:           tt.fields.rows,
+				rows:           newRowStore(tt.fields.rows),
 				alignment:      tt.fields.alignment,
 				numHeaderRows:  tt.fields.numHeaderRows,
 				numLabelLevels: tt.fields.numLabelLevels,
@@ -736,8 +1123,8 @@ func TestTable_AppendHeaderRow(t *testing.T) {
 			if err := tbl.AppendHeaderRow(tt.args.row); (err != nil) != tt.wantErr {
 				t.Errorf("Table.AppendHeaderRow() error = %v, wantErr %v", err, tt.wantErr)
 			}
-			if !reflect.DeepEqual(tbl.rows, tt.wantRows) {
-				t.Errorf("Table.AppendHeaderRow().rows -> %v, want %v", tbl.rows, tt.wantRows)
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.AppendHeaderRow().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
 			}
 
 			if tbl.numHeaderRows != tt.wantNumberHeaderRows {
@@ -786,7 +1173,7 @@ func TestTable_AppendRow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tbl := &Table{
 				w:              tt.fields.w,
-				rows:           tt.fields.rows,
+				rows:           newRowStore(tt.fields.rows),
 				alignment:      tt.fields.alignment,
 				numHeaderRows:  tt.fields.numHeaderRows,
 				numLabelLevels: tt.fields.numLabelLevels,
@@ -796,13 +1183,103 @@ func TestTable_AppendRow(t *testing.T) {
 			if err := tbl.AppendRow(tt.args.row); (err != nil) != tt.wantErr {
 				t.Errorf("Table.AppendRow() error = %v, wantErr %v", err, tt.wantErr)
 			}
-			if !reflect.DeepEqual(tbl.rows, tt.wantRows) {
-				t.Errorf("Table.AppendHeaderRow().rows -> %v, want %v", tbl.rows, tt.wantRows)
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.AppendHeaderRow().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestTable_AppendStyledRow(t *testing.T) {
+	type fields struct {
+		rows [][]string
+	}
+	type args struct {
+		row []Cell
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		args       args
+		wantRows   [][]string
+		wantStyles []Style
+		wantErr    bool
+	}{
+		{"pass",
+			fields{
+				rows: [][]string{{"foo", "bar"}},
+			},
+			args{[]Cell{{Text: "baz"}, {Text: "FAILED", Style: Style{Foreground: ColorRed}}}},
+			[][]string{{"foo", "bar"}, {"baz", "FAILED"}},
+			[]Style{{}, {Foreground: ColorRed}},
+			false},
+		{"pass - links",
+			fields{
+				rows: [][]string{{"foo", "bar"}},
+			},
+			args{[]Cell{{Text: "docs", Link: "http://x/docs"}, {Link: "http://x"}}},
+			[][]string{{"foo", "bar"}, {"docs", "http://x"}},
+			[]Style{{link: "http://x/docs"}, {link: "http://x"}},
+			false},
+		{"fail - wrong shape",
+			fields{
+				rows: [][]string{{"foo", "bar"}},
+			},
+			args{[]Cell{{Text: "baz"}}},
+			[][]string{{"foo", "bar"}},
+			nil,
+			true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{
+				rows: newRowStore(tt.fields.rows),
+			}
+			if err := tbl.AppendStyledRow(tt.args.row); (err != nil) != tt.wantErr {
+				t.Errorf("Table.AppendStyledRow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.AppendStyledRow().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+			if got := tbl.rows.at(tbl.rows.len() - 1).styles; !reflect.DeepEqual(got, tt.wantStyles) {
+				t.Errorf("Table.AppendStyledRow().styles -> %v, want %v", got, tt.wantStyles)
 			}
 		})
 	}
 }
 
+func TestTable_DisableHyperlinks(t *testing.T) {
+	tbl := newTestTable([]string{"page"}, nil)
+	tbl.AppendStyledRow([]Cell{{Text: "docs", Link: "http://x"}})
+	want := "" +
+		"+------+\n" +
+		"| page |\n" +
+		"|------|\n" +
+		"| \x1b]8;;http://x\x1b\\docs\x1b]8;;\x1b\\ |\n" +
+		"+------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %q, want %q", got, want)
+	}
+	tbl.DisableHyperlinks()
+	want = "" +
+		"+------+\n" +
+		"| page |\n" +
+		"|------|\n" +
+		"| docs |\n" +
+		"+------+\n"
+	got, err = tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.DisableHyperlinks() render -> %q, want %q", got, want)
+	}
+}
+
 func TestTable_AppendRows(t *testing.T) {
 	type fields struct {
 		w              io.Writer
@@ -842,7 +1319,7 @@ func TestTable_AppendRows(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tbl := &Table{
 				w:              tt.fields.w,
-				rows:           tt.fields.rows,
+				rows:           newRowStore(tt.fields.rows),
 				alignment:      tt.fields.alignment,
 				numHeaderRows:  tt.fields.numHeaderRows,
 				numLabelLevels: tt.fields.numLabelLevels,
@@ -852,13 +1329,81 @@ func TestTable_AppendRows(t *testing.T) {
 			if err := tbl.AppendRows(tt.args.rows); (err != nil) != tt.wantErr {
 				t.Errorf("Table.AppendRows() error = %v, wantErr %v", err, tt.wantErr)
 			}
-			if !reflect.DeepEqual(tbl.rows, tt.wantRows) {
-				t.Errorf("Table.AppendHeaderRow().rows -> %v, want %v", tbl.rows, tt.wantRows)
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.AppendHeaderRow().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestTable_AppendFromChannel(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     [][]string
+		wantRows [][]string
+		wantErr  bool
+	}{
+		{"pass", [][]string{{"bar"}, {"baz"}}, [][]string{{"foo"}, {"bar"}, {"baz"}}, false},
+		{"fail - bad shape", [][]string{{"bar"}, {"corge", "qux"}, {"baz"}}, [][]string{{"foo"}, {"bar"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{rows: newRowStore([][]string{{"foo"}})}
+			ch := make(chan []string)
+			go func() {
+				for _, row := range tt.rows {
+					ch <- row
+				}
+				close(ch)
+			}()
+			if err := tbl.AppendFromChannel(ch); (err != nil) != tt.wantErr {
+				t.Errorf("Table.AppendFromChannel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tbl.rows.all(), tt.wantRows) {
+				t.Errorf("Table.AppendFromChannel().rows -> %v, want %v", tbl.rows.all(), tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestTable_SetHeaderStyle(t *testing.T) {
+	type fields struct {
+		headerStyle Style
+	}
+	type args struct {
+		style Style
+	}
+	tests := []struct {
+		name            string
+		fields          fields
+		args            args
+		wantHeaderStyle Style
+	}{
+		{"pass", fields{headerStyle: Style{}}, args{Style{Bold: true}}, Style{Bold: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{
+				headerStyle: tt.fields.headerStyle,
+			}
+			tbl.SetHeaderStyle(tt.args.style)
+
+			if tbl.headerStyle != tt.wantHeaderStyle {
+				t.Errorf("Table.SetHeaderStyle().headerStyle -> %v, want %v", tbl.headerStyle, tt.wantHeaderStyle)
 			}
 		})
 	}
 }
 
+func TestTable_EnableZebraStripes(t *testing.T) {
+	tbl := &Table{}
+	tbl.EnableZebraStripes(Style{Background: ColorBlue}, Style{})
+	want := &[2]Style{{Background: ColorBlue}, {}}
+	if !reflect.DeepEqual(tbl.zebraStripes, want) {
+		t.Errorf("Table.EnableZebraStripes().zebraStripes -> %v, want %v", tbl.zebraStripes, want)
+	}
+}
+
 func TestTable_MergeRepeats(t *testing.T) {
 	type fields struct {
 		autoMerge bool
@@ -884,6 +1429,120 @@ func TestTable_MergeRepeats(t *testing.T) {
 	}
 }
 
+func TestTable_Clone(t *testing.T) {
+	tests := []struct {
+		name        string
+		includeBody bool
+		wantRows    [][]string
+	}{
+		{"template", false, [][]string{{"foo", "bar"}}},
+		{"include body", true, [][]string{{"foo", "bar"}, {"baz", "qux"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := NewTable(&bytes.Buffer{})
+			tbl.AppendHeaderRow([]string{"foo", "bar"})
+			tbl.AppendRow([]string{"baz", "qux"})
+			tbl.SetAlignment(AlignLeft)
+			tbl.EnableZebraStripes(Style{Bold: true}, Style{})
+			tbl.render()
+
+			got := tbl.Clone(tt.includeBody)
+			if !reflect.DeepEqual(got.rows.all(), tt.wantRows) {
+				t.Errorf("Table.Clone().rows -> %v, want %v", got.rows.all(), tt.wantRows)
+			}
+			if got.w != tbl.w || got.alignment != AlignLeft || got.numHeaderRows != 1 {
+				t.Errorf("Table.Clone() -> %v, want same settings as %v", got, tbl)
+			}
+			if got.scratch != nil {
+				t.Errorf("Table.Clone().scratch -> %v, want nil", got.scratch)
+			}
+			// changes to the clone do not affect the original
+			got.rows.at(0).cells[0] = "corge"
+			got.zebraStripes[0] = Style{}
+			if tbl.rows.at(0).cells[0] != "foo" || tbl.zebraStripes[0] != (Style{Bold: true}) {
+				t.Errorf("Table.Clone() -> changes to clone affected original %v", tbl)
+			}
+		})
+	}
+}
+
+func TestTable_ClearRows(t *testing.T) {
+	tbl := NewTable(nil)
+	tbl.AppendHeaderRow([]string{"foo"})
+	tbl.AppendRows([][]string{{"bar"}, {"baz"}})
+	tbl.SetAlignment(AlignLeft)
+	tbl.ClearRows()
+
+	if want := [][]string{{"foo"}}; !reflect.DeepEqual(tbl.rows.all(), want) {
+		t.Errorf("Table.ClearRows().rows -> %v, want %v", tbl.rows.all(), want)
+	}
+	if tbl.numHeaderRows != 1 || tbl.alignment != AlignLeft {
+		t.Errorf("Table.ClearRows() -> %v, want header rows and settings retained", tbl)
+	}
+}
+
+func TestTable_Reset(t *testing.T) {
+	w := &bytes.Buffer{}
+	tbl := NewTable(w)
+	tbl.AppendHeaderRow([]string{"foo"})
+	tbl.AppendRows([][]string{{"bar"}, {"baz"}})
+	tbl.SetAlignment(AlignLeft)
+	tbl.EnableArenaStorage()
+	tbl.Reset()
+
+	if tbl.rows.len() != 0 || tbl.rows.arena != nil {
+		t.Errorf("Table.Reset().rows -> %v, want empty", tbl.rows)
+	}
+	if tbl.w != w || tbl.numHeaderRows != 0 || tbl.alignment != AlignCenter || !tbl.autoCenterHeaders {
+		t.Errorf("Table.Reset() -> %v, want default settings", tbl)
+	}
+}
+
+func TestTable_SetHeaderDivider(t *testing.T) {
+	tests := []struct {
+		name    string
+		filler  string
+		edge    string
+		want    string
+		wantErr bool
+	}{
+		{"filler only", "=", "", "" +
+			"+---++---+\n" +
+			"| a || b |\n" +
+			"|===||===|\n" +
+			"| 1 || 2 |\n" +
+			"+---++---+\n", false},
+		{"filler and edge", "═", "╪", "" +
+			"+---++---+\n" +
+			"| a || b |\n" +
+			"╪═══||═══╪\n" +
+			"| 1 || 2 |\n" +
+			"+---++---+\n", false},
+		{"fail - wide filler", "==", "", "", true},
+		{"fail - wide edge", "=", "++", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"a", "b"}, [][]string{{"1", "2"}})
+			tbl.SetLabelLevelCount(1)
+			if err := tbl.SetHeaderDivider(tt.filler, tt.edge); (err != nil) != tt.wantErr {
+				t.Fatalf("Table.SetHeaderDivider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTable_DisableHeaderAutoCentering(t *testing.T) {
 	type fields struct {
 		autoCenterHeaders bool
@@ -934,6 +1593,116 @@ func TestTable_TruncateCells(t *testing.T) {
 	}
 }
 
+func TestTable_TrimTrailingWhitespace(t *testing.T) {
+	type fields struct {
+		trimTrailingSpace bool
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantTrim bool
+	}{
+		{"pass", fields{trimTrailingSpace: false}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{
+				trimTrailingSpace: tt.fields.trimTrailingSpace,
+			}
+			tbl.TrimTrailingWhitespace()
+
+			if tbl.trimTrailingSpace != tt.wantTrim {
+				t.Errorf("Table.TrimTrailingWhitespace().trimTrailingSpace -> %v, want %v", tbl.trimTrailingSpace, tt.wantTrim)
+			}
+		})
+	}
+}
+
+func TestTable_SetLineEnding(t *testing.T) {
+	tbl := newTestTable([]string{"foo"}, [][]string{{"a\nb"}})
+	if err := tbl.SetLineEnding(LineEndingCRLF); err != nil {
+		t.Fatalf("Table.SetLineEnding() error = %v", err)
+	}
+	want := "" +
+		"+-----+\r\n" +
+		"| foo |\r\n" +
+		"|-----|\r\n" +
+		"|  a  |\r\n" +
+		"|  b  |\r\n" +
+		"+-----+\r\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %q, want %q", got, want)
+	}
+	if block, _ := tbl.RenderBlock(); block != strings.TrimSuffix(want, "\r\n") {
+		t.Errorf("Table.RenderBlock() -> %q, want %q", block, strings.TrimSuffix(want, "\r\n"))
+	}
+	if width, height, _ := tbl.Dimensions(); width != 7 || height != 6 {
+		t.Errorf("Table.Dimensions() -> %v, %v, want 7, 6", width, height)
+	}
+
+	if err := tbl.SetLineEnding(LineEndingAuto); err != nil {
+		t.Fatalf("Table.SetLineEnding() error = %v", err)
+	}
+	wantNewline := LineEndingLF
+	if runtime.GOOS == "windows" {
+		wantNewline = LineEndingCRLF
+	}
+	if got, want := tbl.newline(), wantNewline; got != want {
+		t.Errorf("Table.SetLineEnding(LineEndingAuto) -> %q, want %q", got, want)
+	}
+	if err := tbl.SetLineEnding("\r"); err == nil {
+		t.Errorf("Table.SetLineEnding() error = nil, want error")
+	}
+}
+
+func Test_trimTrailingSpaces(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"no trailing space", "| foo |\n", "| foo |\n"},
+		{"trailing space", " foo  bar  \n baz  qux  \n", " foo  bar\n baz  qux\n"},
+		{"preserves leading and inner space", "  foo   bar", "  foo   bar"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trimTrailingSpaces(tt.s); got != tt.want {
+				t.Errorf("trimTrailingSpaces() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_EnableAutoIndex(t *testing.T) {
+	type fields struct {
+		autoIndex bool
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		wantAutoIndex bool
+	}{
+		{"pass", fields{autoIndex: false}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{
+				autoIndex: tt.fields.autoIndex,
+			}
+			tbl.EnableAutoIndex()
+
+			if tbl.autoIndex != tt.wantAutoIndex {
+				t.Errorf("Table.EnableAutoIndex().autoIndex -> %v, want %v", tbl.autoIndex, tt.wantAutoIndex)
+			}
+		})
+	}
+}
+
 func TestTable_SetAlignment(t *testing.T) {
 	type fields struct {
 		alignment Alignment
@@ -1092,3 +1861,456 @@ func TestChangeDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestChangeDefaults_junctions(t *testing.T) {
+	ChangeDefaults(Defaults{
+		BorderEdge: "│", BorderFiller: "─", HeaderEdge: "│", HeaderFiller: "─", ContentEdge: "│",
+		TopLeft: "┌", TopJunction: "┬", TopRight: "┐",
+		HeaderLeft: "├", HeaderCross: "┼", HeaderRight: "┤",
+		MiddleLeft: "├", MiddleCross: "┼", MiddleRight: "┤",
+		BottomLeft: "└", BottomJunction: "┴", BottomRight: "┘",
+	})
+	defer resetDefaults()
+	tbl := newTestTable([]string{"a", "b"}, [][]string{{"1", "2"}})
+	tbl.AppendDivider()
+	tbl.AppendRow([]string{"3", "4"})
+	want := "" +
+		"┌───┬───┐\n" +
+		"│ a │ b │\n" +
+		"├───┼───┤\n" +
+		"│ 1 │ 2 │\n" +
+		"├───┼───┤\n" +
+		"│ 3 │ 4 │\n" +
+		"└───┴───┘\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func BenchmarkTable_Render(b *testing.B) {
+	for _, numRows := range []int{100000} {
+		b.Run(fmt.Sprint(numRows), func(b *testing.B) {
+			tbl := benchmarkTable(numRows)
+			tbl.w = ioutil.Discard
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if err := tbl.Render(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func Benchmark_stringifyDividingRow(b *testing.B) {
+	colWidths := []int{10, 30, 30, 5}
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		stringifyDividingRow(colWidths, rowLayout{labelEdge: 0}, dividingTop)
+	}
+}
+
+func BenchmarkTable_stringifyContentRow(b *testing.B) {
+	tbl := &Table{alignment: AlignLeft}
+	colWidths := []int{10, 30, 30}
+	row := []string{"å¬ßø", "much too long to fit on a single line of this table", "short"}
+	content := make([]string, len(row))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		copy(content, row)
+		tbl.stringifyContentRow(colWidths, content, nil, false)
+	}
+}
+
+func TestTable_SetLabelSide(t *testing.T) {
+	tests := []struct {
+		name      string
+		autoIndex bool
+		want      string
+	}{
+		{"label level", false, "" +
+			"+-----+-----++-----+\n" +
+			"| qux | bar || foo |\n" +
+			"|-----|-----||-----|\n" +
+			"|  1  |  2  ||  a  |\n" +
+			"+-----+-----++-----+\n"},
+		{"auto index", true, "" +
+			"+-----+-----++-----+---+\n" +
+			"| qux | bar || foo |   |\n" +
+			"|-----|-----||-----|---|\n" +
+			"|  1  |  2  ||  a  | 1 |\n" +
+			"+-----+-----++-----+---+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"qux", "bar", "foo"}, [][]string{{"1", "2", "a"}})
+			tbl.SetLabelLevelCount(1)
+			tbl.SetLabelSide(SideRight)
+			if tt.autoIndex {
+				tbl.EnableAutoIndex()
+			}
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetBorders(t *testing.T) {
+	tests := []struct {
+		name                     string
+		top, bottom, left, right bool
+		want                     string
+	}{
+		{"all", true, true, true, true, "" +
+			"+-----++-----+\n" +
+			"| foo || bar |\n" +
+			"|-----||-----|\n" +
+			"|  a  ||  b  |\n" +
+			"+-----++-----+\n"},
+		{"none", false, false, false, false, "" +
+			" foo || bar \n" +
+			"-----||-----\n" +
+			"  a  ||  b  \n"},
+		{"left and right only", false, false, true, true, "" +
+			"| foo || bar |\n" +
+			"|-----||-----|\n" +
+			"|  a  ||  b  |\n"},
+		{"top and bottom only", true, true, false, false, "" +
+			"-----++-----\n" +
+			" foo || bar \n" +
+			"-----||-----\n" +
+			"  a  ||  b  \n" +
+			"-----++-----\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"a", "b"}})
+			tbl.SetLabelLevelCount(1)
+			tbl.DisableBorders()
+			tbl.SetBorders(tt.top, tt.bottom, tt.left, tt.right)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_AppendDivider(t *testing.T) {
+	tbl := NewTable(nil)
+	tbl.AppendHeaderRow([]string{"section", "n"})
+	tbl.AppendDivider()
+	tbl.AppendRows([][]string{{"foo", "1"}, {"foo", "2"}})
+	tbl.AppendDivider()
+	tbl.AppendDivider()
+	tbl.AppendRow([]string{"bar", "3"})
+	tbl.AppendDivider()
+	want := "" +
+		"+---------+---+\n" +
+		"| section | n |\n" +
+		"|---------|---|\n" +
+		"|   foo   | 1 |\n" +
+		"|   foo   | 2 |\n" +
+		"+---------+---+\n" +
+		"|   bar   | 3 |\n" +
+		"+---------+---+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	tbl.ClearRows()
+	if len(tbl.dividers) != 0 {
+		t.Errorf("Table.ClearRows().dividers -> %v, want none", tbl.dividers)
+	}
+}
+
+func TestTable_render_multiLineCells(t *testing.T) {
+	inner := newTestTable([]string{"k", "v"}, [][]string{{"a", "1"}})
+	block, err := inner.RenderBlock()
+	if err != nil {
+		t.Fatalf("Table.RenderBlock() error = %v", err)
+	}
+	tbl := newTestTable([]string{"name", "detail"}, [][]string{{"foo", block}, {"bar", "x\n\ny"}})
+	tbl.SetAlignment(AlignLeft)
+	want := "" +
+		"+------+-----------+\n" +
+		"| name |  detail   |\n" +
+		"|------|-----------|\n" +
+		"| foo  | +---+---+ |\n" +
+		"|      | | k | v | |\n" +
+		"|      | |---|---| |\n" +
+		"|      | | a | 1 | |\n" +
+		"|      | +---+---+ |\n" +
+		"| bar  | x         |\n" +
+		"|      |           |\n" +
+		"|      | y         |\n" +
+		"+------+-----------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_render_multiLineWrappedCell(t *testing.T) {
+	ChangeDefaults(Defaults{MaxColWidth: 5})
+	defer resetDefaults()
+	tbl := newTestTable([]string{"foo"}, [][]string{{"abcdefg\nhi"}})
+	tbl.SetAlignment(AlignLeft)
+	want := "" +
+		"+-------+\n" +
+		"|  foo  |\n" +
+		"|-------|\n" +
+		"| abcd- |\n" +
+		"| efg   |\n" +
+		"| hi    |\n" +
+		"+-------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_SetMaxLinesPerCell(t *testing.T) {
+	ChangeDefaults(Defaults{MaxColWidth: 8})
+	defer resetDefaults()
+	tests := []struct {
+		name     string
+		maxLines int
+		want     string
+	}{
+		{"two lines", 2, "" +
+			"+----------+-------+\n" +
+			"|   foo    | count |\n" +
+			"|----------|-------|\n" +
+			"| the qui- | 1     |\n" +
+			"| ck br... | 2...  |\n" +
+			"+----------+-------+\n"},
+		{"one line", 1, "" +
+			"+----------+-------+\n" +
+			"|   foo    | count |\n" +
+			"|----------|-------|\n" +
+			"| the q... | 1...  |\n" +
+			"+----------+-------+\n"},
+		{"enough lines", 3, "" +
+			"+----------+-------+\n" +
+			"|   foo    | count |\n" +
+			"|----------|-------|\n" +
+			"| the qui- | 1     |\n" +
+			"| ck brow- | 2     |\n" +
+			"| n fox    | 3     |\n" +
+			"+----------+-------+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo", "count"}, [][]string{{"the quick brown fox", "1\n2\n3"}})
+			tbl.SetAlignment(AlignLeft)
+			tbl.SetMaxLinesPerCell(tt.maxLines)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetHeaderOverflow(t *testing.T) {
+	ChangeDefaults(Defaults{MaxColWidth: 6})
+	defer resetDefaults()
+	tests := []struct {
+		name     string
+		overflow Overflow
+		want     string
+	}{
+		{"default", OverflowDefault, "" +
+			"+-----------------+------+\n" +
+			"| number of items | name |\n" +
+			"|-----------------|------|\n" +
+			"|        1        | foo  |\n" +
+			"+-----------------+------+\n"},
+		{"wrap", OverflowWrap, "" +
+			"+--------+------+\n" +
+			"| numbe- | name |\n" +
+			"|  r of  |      |\n" +
+			"| items  |      |\n" +
+			"|--------|------|\n" +
+			"|   1    | foo  |\n" +
+			"+--------+------+\n"},
+		{"truncate", OverflowTruncate, "" +
+			"+--------+------+\n" +
+			"| num... | name |\n" +
+			"|--------|------|\n" +
+			"|   1    | foo  |\n" +
+			"+--------+------+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"number of items", "name"}, [][]string{{"1", "foo"}})
+			tbl.SetHeaderOverflow(tt.overflow)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetWrapContinuation(t *testing.T) {
+	ChangeDefaults(Defaults{MaxColWidth: 8})
+	defer resetDefaults()
+	tests := []struct {
+		name      string
+		marker    string
+		alignment *Alignment
+		want      string
+	}{
+		{"marker", "↳ ", nil, "" +
+			"+----------+-----+\n" +
+			"|   foo    | bar |\n" +
+			"|----------|-----|\n" +
+			"|      the |   1 |\n" +
+			"| quick b- |   2 |\n" +
+			"|   ↳ rown |     |\n" +
+			"|    ↳ fox |     |\n" +
+			"+----------+-----+\n"},
+		{"hanging indent", "  ", alignmentPtr(AlignLeft), "" +
+			"+----------+-----+\n" +
+			"|   foo    | bar |\n" +
+			"|----------|-----|\n" +
+			"|      the |   1 |\n" +
+			"| quick b- |   2 |\n" +
+			"|   rown   |     |\n" +
+			"|   fox    |     |\n" +
+			"+----------+-----+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"the\nquick brown fox", "1\n2"}})
+			tbl.SetAlignment(AlignRight)
+			tbl.SetWrapContinuation(tt.marker)
+			if tt.alignment != nil {
+				tbl.SetWrapContinuationAlignment(*tt.alignment)
+			}
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func alignmentPtr(alignment Alignment) *Alignment {
+	return &alignment
+}
+
+func Test_blockWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"", 0},
+		{"foo", 3},
+		{"a\nbcd\n", 3},
+		{"ab\nc", 2},
+	}
+	for _, tt := range tests {
+		if got := blockWidth(tt.s); got != tt.want {
+			t.Errorf("blockWidth(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestTable_render_truncateANSI(t *testing.T) {
+	ChangeDefaults(Defaults{MaxColWidth: 5})
+	defer resetDefaults()
+	tbl := newTestTable([]string{"foo"}, [][]string{{"\x1b[31mfoobar\x1b[0m"}, {"\x1b[32mok\x1b[0m"}})
+	tbl.TruncateWideCells()
+	want := "" +
+		"+-------+\n" +
+		"|  foo  |\n" +
+		"|-------|\n" +
+		"| \x1b[31mfo...\x1b[0m |\n" +
+		"|  \x1b[32mok\x1b[0m   |\n" +
+		"+-------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %q, want %q", got, want)
+	}
+}
+
+func TestTable_SetDefaultMinColumnWidth(t *testing.T) {
+	tbl := newTestTable([]string{"name", "x"}, [][]string{{"much too long", "y"}})
+	tbl.SetDefaultMinColumnWidth(3)
+	tbl.SetMinColumnWidth(0, 6)
+	tbl.SetTotalWidth(10)
+	tbl.TruncateWideCells()
+	want := "" +
+		"+--------+-----+\n" +
+		"|  name  |  x  |\n" +
+		"|--------|-----|\n" +
+		"| muc... |  y  |\n" +
+		"+--------+-----+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_SetColumnSpacing(t *testing.T) {
+	tbl := newTestTable([]string{"name", "count"}, [][]string{{"foo", "1"}, {"much too long", "22"}})
+	tbl.AppendDivider()
+	tbl.AppendRow([]string{"total", "23"})
+	tbl.SetColumnSpacing(2)
+	tbl.SetAlignment(AlignLeft)
+	tbl.SetColumnAlignment(1, AlignRight)
+	tbl.SetMaxColumnWidth(8)
+	want := "" +
+		"  name    count\n" +
+		"foo           1\n" +
+		"much to-     22\n" +
+		"o long\n" +
+		"total        23\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,125 @@
+// Package tabletest provides golden-file assertions for tables rendered by tablewriter,
+// so that consumers don't need to write the same comparison boilerplate in every test.
+//
+// Golden files are rewritten with the current output when tests are run with the -tabletest.update flag:
+//
+//	go test ./... -tabletest.update
+package tabletest
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ptiger10/tablewriter"
+)
+
+var update = flag.Bool("tabletest.update", false, "rewrite golden files with the current rendered output")
+
+// An Option configures how rendered output and golden files are normalized before they are compared.
+type Option func(*options)
+
+type options struct {
+	trimTrailingSpace   bool
+	normalizeLineEnding bool
+}
+
+// IgnoreTrailingSpace ignores spaces and tabs at the end of every line.
+func IgnoreTrailingSpace() Option {
+	return func(o *options) { o.trimTrailingSpace = true }
+}
+
+// IgnoreLineEndings treats "\r\n" line endings as "\n", so that golden files checked out with Windows line endings still match.
+func IgnoreLineEndings() Option {
+	return func(o *options) { o.normalizeLineEnding = true }
+}
+
+// normalize returns `b` normalized according to the options.
+func (o options) normalize(b []byte) string {
+	s := string(b)
+	if o.normalizeLineEnding {
+		s = strings.Replace(s, "\r\n", "\n", -1)
+	}
+	if o.trimTrailingSpace {
+		lines := strings.Split(s, "\n")
+		for i := range lines {
+			lines[i] = strings.TrimRight(lines[i], " \t")
+		}
+		s = strings.Join(lines, "\n")
+	}
+	return s
+}
+
+// AssertRenders renders `tbl` and reports a test failure if the output does not match the contents of the golden file at `goldenPath`.
+// If the -tabletest.update flag is set, the golden file (and its directory) is created or overwritten with the output instead.
+func AssertRenders(t testing.TB, tbl *tablewriter.Table, goldenPath string, opts ...Option) {
+	t.Helper()
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var got bytes.Buffer
+	if err := tbl.RenderTo(&got); err != nil {
+		t.Fatalf("tabletest.AssertRenders(): %v", err)
+		return
+	}
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(goldenPath), 0755); err != nil {
+			t.Fatalf("tabletest.AssertRenders(): %v", err)
+			return
+		}
+		if err := ioutil.WriteFile(goldenPath, got.Bytes(), 0644); err != nil {
+			t.Fatalf("tabletest.AssertRenders(): %v", err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("tabletest.AssertRenders(): %v (run with -tabletest.update to create it)", err)
+		return
+	}
+	if line, ok := firstDifference(o.normalize(got.Bytes()), o.normalize(want)); !ok {
+		t.Errorf("tabletest.AssertRenders(): output does not match %s at line %d\n%s", goldenPath, line.number, line)
+	}
+}
+
+// A difference is the first line at which two outputs differ.
+type difference struct {
+	number    int
+	got, want string
+}
+
+func (d difference) String() string {
+	return "got:  " + d.got + "\nwant: " + d.want
+}
+
+// firstDifference returns the first line (numbered from 1) at which `got` and `want` differ, or true if they are equal.
+// Lines are quoted so that differences in whitespace are visible.
+func firstDifference(got, want string) (difference, bool) {
+	if got == want {
+		return difference{}, true
+	}
+	gotLines, wantLines := strings.Split(got, "\n"), strings.Split(want, "\n")
+	for i := 0; ; i++ {
+		var d difference
+		d.number = i + 1
+		if i < len(gotLines) {
+			d.got = strconv.Quote(gotLines[i])
+		} else {
+			d.got = "<end of output>"
+		}
+		if i < len(wantLines) {
+			d.want = strconv.Quote(wantLines[i])
+		} else {
+			d.want = "<end of output>"
+		}
+		if d.got != d.want {
+			return d, false
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package tabletest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ptiger10/tablewriter"
+)
+
+// recorder records failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failed bool
+	msg    string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failed = true
+	r.msg = fmt.Sprintf(format, args...)
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+}
+
+func newTable() *tablewriter.Table {
+	tbl := tablewriter.NewTable(nil)
+	tbl.AppendHeaderRow([]string{"name", "n"})
+	tbl.AppendRow([]string{"foo", "1"})
+	return tbl
+}
+
+func TestAssertRenders(t *testing.T) {
+	tests := []struct {
+		name       string
+		goldenPath string
+		opts       []Option
+		wantFailed bool
+	}{
+		{"match", "testdata/table.golden", nil, false},
+		{"trailing space", "testdata/trailing_space.golden", nil, true},
+		{"ignore trailing space", "testdata/trailing_space.golden", []Option{IgnoreTrailingSpace()}, false},
+		{"line endings", "testdata/crlf.golden", nil, true},
+		{"ignore line endings", "testdata/crlf.golden", []Option{IgnoreLineEndings()}, false},
+		{"missing", "testdata/missing.golden", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			AssertRenders(r, newTable(), tt.goldenPath, tt.opts...)
+			if r.failed != tt.wantFailed {
+				t.Errorf("AssertRenders() failed = %v (%s), want %v", r.failed, r.msg, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestAssertRenders_update(t *testing.T) {
+	*update = true
+	defer func() { *update = false }()
+	dir, err := ioutil.TempDir("", "tabletest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "new", "table.golden")
+	AssertRenders(t, newTable(), path)
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("AssertRenders() did not write golden file: %v", err)
+	}
+	want, _ := ioutil.ReadFile("testdata/table.golden")
+	if string(got) != string(want) {
+		t.Errorf("AssertRenders() wrote %q, want %q", got, want)
+	}
+}
+
+func Test_firstDifference(t *testing.T) {
+	tests := []struct {
+		name      string
+		got, want string
+		wantLine  int
+		wantEqual bool
+	}{
+		{"equal", "a\nb\n", "a\nb\n", 0, true},
+		{"changed line", "a\nb\n", "a\nc\n", 2, false},
+		{"extra line", "a\nb\n", "a\n", 2, false},
+		{"marker text", "a\n<end of output>", "a", 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, equal := firstDifference(tt.got, tt.want)
+			if equal != tt.wantEqual || d.number != tt.wantLine {
+				t.Errorf("firstDifference() = %v, %v, want line %v, %v", d.number, equal, tt.wantLine, tt.wantEqual)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package tablewriter
+
+import "fmt"
+
+// AppendRowWithTags appends a non-header row to the table, with `tags` attached as arbitrary metadata (e.g., {"status": "failed"}).
+// Tags are never rendered, but are available to FilterTaggedRows and SetRowStyler,
+// so that filtering and styling logic need not depend on the text of the cells.
+// Tags stay with their row if rows are sorted, filtered, or pinned.
+func (tbl *Table) AppendRowWithTags(row []string, tags map[string]string) error {
+	cells, err := tbl.reshape(row, tbl.NumRows())
+	if err != nil {
+		return fmt.Errorf("tbl.AppendRowWithTags(): appending row (%v): %v", row, err)
+	}
+	tbl.rows.append(record{cells: cells, tags: copyTags(tags)})
+	return nil
+}
+
+// RowTags returns a copy of the tags attached to the non-header row at position `i` (0 is the first non-header row),
+// or nil if the row has no tags.
+func (tbl *Table) RowTags(i int) (map[string]string, error) {
+	n := tbl.numHeaderRows + i
+	if i < 0 || n >= tbl.rows.len() {
+		return nil, fmt.Errorf("tbl.RowTags(): row %d out of range [0:%d]", i, tbl.rows.len()-tbl.numHeaderRows)
+	}
+	return copyTags(tbl.rows.at(n).tags), nil
+}
+
+// SetRowStyler styles every cell in each non-header row at render time with the style returned by `styler`,
+// given the stored row and the tags attached to it (nil if the row has no tags).
+// The row style takes precedence over zebra stripes, and any styles set on individual cells take precedence over the row style.
+// A nil styler removes any existing styler.
+func (tbl *Table) SetRowStyler(styler func(row []string, tags map[string]string) Style) {
+	tbl.rowStyler = styler
+}
+
+// copyTags returns a copy of `tags`, or nil if `tags` is empty.
+func copyTags(tags map[string]string) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	ret := make(map[string]string, len(tags))
+	for k, v := range tags {
+		ret[k] = v
+	}
+	return ret
+}
@@ -0,0 +1,60 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTable_AppendRowWithTags(t *testing.T) {
+	tbl := newTestTable([]string{"job"}, [][]string{{"build"}})
+	tags := map[string]string{"status": "failed"}
+	if err := tbl.AppendRowWithTags([]string{"test"}, tags); err != nil {
+		t.Fatalf("Table.AppendRowWithTags() error = %v", err)
+	}
+	if err := tbl.AppendRowWithTags([]string{"deploy", "extra"}, nil); err == nil {
+		t.Errorf("Table.AppendRowWithTags() with wrong shape error = nil, want error")
+	}
+	tags["status"] = "changed"
+	tbl.SortRows(func(a, b []string) bool { return a[0] > b[0] })
+	got, err := tbl.RowTags(0)
+	if err != nil {
+		t.Fatalf("Table.RowTags() error = %v", err)
+	}
+	if want := map[string]string{"status": "failed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Table.RowTags(0) -> %v, want %v", got, want)
+	}
+	if got, _ := tbl.RowTags(1); got != nil {
+		t.Errorf("Table.RowTags(1) -> %v, want nil", got)
+	}
+	if _, err := tbl.RowTags(2); err == nil {
+		t.Errorf("Table.RowTags(2) error = nil, want error")
+	}
+}
+
+func TestTable_FilterTaggedRows(t *testing.T) {
+	tbl := newTestTable([]string{"job"}, [][]string{{"build"}})
+	tbl.AppendRowWithTags([]string{"test"}, map[string]string{"status": "failed"})
+	tbl.FilterTaggedRows(func(_ []string, tags map[string]string) bool { return tags["status"] == "failed" })
+	if want := [][]string{{"job"}, {"test"}}; !reflect.DeepEqual(tbl.rows.all(), want) {
+		t.Errorf("Table.FilterTaggedRows() -> %v, want %v", tbl.rows.all(), want)
+	}
+}
+
+func TestTable_SetRowStyler(t *testing.T) {
+	tbl := newTestTable([]string{"job"}, [][]string{{"build"}})
+	tbl.AppendRowWithTags([]string{"test"}, map[string]string{"status": "failed"})
+	tbl.EnableZebraStripes(Style{Bold: true}, Style{Bold: true})
+	tbl.SetRowStyler(func(_ []string, tags map[string]string) Style {
+		if tags["status"] == "failed" {
+			return Style{Foreground: ColorRed}
+		}
+		return Style{}
+	})
+	v := tbl.view()
+	want := [][]Style{nil, {{Bold: true}}, {{Foreground: ColorRed, Bold: true}}}
+	for i := range want {
+		if got := v.rows.at(i).styles; !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("Table.view().rows.at(%d).styles -> %v, want %v", i, got, want[i])
+		}
+	}
+}
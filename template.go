@@ -0,0 +1,29 @@
+package tablewriter
+
+import "fmt"
+
+// TemplateFunc returns a function that renders a table inline, for use in a text/template FuncMap, e.g.:
+//
+//	tmpl := template.New("report").Funcs(template.FuncMap{"table": tablewriter.TemplateFunc()})
+//	template.Must(tmpl.Parse("Results:\n{{ table .Rows .Headers }}\n"))
+//
+// The function creates a table configured by `opts` followed by any options passed in the template,
+// appends `headers` as a header row (unless empty) and `rows`, and returns the rendered table without a trailing newline (see RenderBlock).
+func TemplateFunc(opts ...Option) func(rows [][]string, headers []string, extra ...Option) (string, error) {
+	return func(rows [][]string, headers []string, extra ...Option) (string, error) {
+		tbl := NewTable(nil, append(append([]Option(nil), opts...), extra...)...)
+		if len(headers) > 0 {
+			if err := tbl.AppendHeaderRow(headers); err != nil {
+				return "", fmt.Errorf("tablewriter.TemplateFunc(): %v", err)
+			}
+		}
+		if err := tbl.AppendRows(rows); err != nil {
+			return "", fmt.Errorf("tablewriter.TemplateFunc(): %v", err)
+		}
+		s, err := tbl.RenderBlock()
+		if err != nil {
+			return "", fmt.Errorf("tablewriter.TemplateFunc(): %v", err)
+		}
+		return s, nil
+	}
+}
@@ -0,0 +1,59 @@
+package tablewriter
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateFunc(t *testing.T) {
+	tmpl := template.Must(template.New("report").Funcs(template.FuncMap{
+		"table":    TemplateFunc(WithAlignment(AlignLeft)),
+		"maxWidth": WithMaxColWidth,
+	}).Parse("Results:\n{{ table .Rows .Headers }}\n{{ table .Rows nil (maxWidth 2) }}\n"))
+	data := struct {
+		Rows    [][]string
+		Headers []string
+	}{
+		Rows:    [][]string{{"foo", "1"}, {"bar", "22"}},
+		Headers: []string{"name", "n"},
+	}
+	var got strings.Builder
+	if err := tmpl.Execute(&got, data); err != nil {
+		t.Fatalf("template.Execute() error = %v", err)
+	}
+	want := "" +
+		"Results:\n" +
+		"+------+----+\n" +
+		"| name | n  |\n" +
+		"|------|----|\n" +
+		"| foo  | 1  |\n" +
+		"| bar  | 22 |\n" +
+		"+------+----+\n" +
+		"+----+----+\n" +
+		"| f- | 1  |\n" +
+		"| oo |    |\n" +
+		"| b- | 22 |\n" +
+		"| ar |    |\n" +
+		"+----+----+\n"
+	if got.String() != want {
+		t.Errorf("TemplateFunc() rendered %v, want %v", got.String(), want)
+	}
+
+	tests := []struct {
+		name    string
+		rows    [][]string
+		headers []string
+	}{
+		{"no rows", nil, nil},
+		{"ragged rows", [][]string{{"a", "b"}, {"c"}}, nil},
+		{"header width mismatch", [][]string{{"a", "b"}}, []string{"foo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := TemplateFunc()(tt.rows, tt.headers); err == nil {
+				t.Errorf("TemplateFunc() error = nil, want error")
+			}
+		})
+	}
+}
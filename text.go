@@ -0,0 +1,35 @@
+package tablewriter
+
+import "github.com/ptiger10/tablewriter/layout"
+
+// MaxCombiningMarks is the maximum number of consecutive combining marks retained by Sanitize after each base character.
+const MaxCombiningMarks = layout.MaxCombiningMarks
+
+// Sanitize returns `s` with invalid UTF-8 replaced with U+FFFD, bidirectional formatting characters removed,
+// and runs of combining marks longer than MaxCombiningMarks shortened (see layout.Sanitize).
+func Sanitize(s string) string {
+	return layout.Sanitize(s)
+}
+
+// Truncate sanitizes `s` and shortens it to at most `width` runes, replacing its end with "...",
+// or with "…" at widths less than 4 (e.g., "…" at width 1). Widths less than 1 return an empty string.
+// Grapheme clusters (e.g., "é" written with a combining accent, or "👨‍👩‍👧") are never split, unless the first cluster alone is too wide.
+func Truncate(s string, width int) string {
+	return layout.Truncate(s, width)
+}
+
+// Wrap sanitizes `s` and splits it into lines of at most `width` runes, preferring to break at spaces
+// and inserting a hyphen when a word is split. Widths less than 1 are treated as 1.
+// Grapheme clusters (e.g., "é" written with a combining accent, or "👨‍👩‍👧") are never split, unless a cluster alone is too wide.
+func Wrap(s string, width int) []string {
+	return layout.Wrap(s, width)
+}
+
+// Align sanitizes `s` and pads it with spaces to `width` runes according to `alignment`, as in a table cell without padding.
+// Text wider than `width` is returned without padding, and AlignDecimal right-justifies the text.
+func Align(s string, width int, alignment Alignment) string {
+	if alignment == AlignDecimal {
+		alignment = AlignRight
+	}
+	return layout.Align(s, width, layout.Alignment(alignment))
+}
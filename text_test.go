@@ -0,0 +1,76 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  []string
+	}{
+		{"fits", "foo", 5, []string{"foo"}},
+		{"at space", "foo bar", 4, []string{"foo", "bar"}},
+		{"mid-word", "foobar", 4, []string{"foo-", "bar"}},
+		{"width 1", "abc", 1, []string{"a", "b", "c"}},
+		{"width 0", "ab", 0, []string{"a", "b"}},
+		{"rtl override", "\u202efoo", 5, []string{"foo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.s, tt.width); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Wrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{"fits", "foo", 3, "foo"},
+		{"ellipsis", "foobar", 5, "fo..."},
+		{"width 3", "foobar", 3, "fo…"},
+		{"width 2", "foobar", 2, "f…"},
+		{"width 1", "foobar", 1, "…"},
+		{"width 0", "foobar", 0, ""},
+		{"negative width", "foobar", -1, ""},
+		{"invalid utf-8", "\xff\xff\xff\xff\xff", 4, "\ufffd..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Truncate(tt.s, tt.width); got != tt.want {
+				t.Errorf("Truncate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlign(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         string
+		width     int
+		alignment Alignment
+		want      string
+	}{
+		{"left", "ab", 4, AlignLeft, "ab  "},
+		{"right", "ab", 4, AlignRight, "  ab"},
+		{"center", "ab", 5, AlignCenter, " ab  "},
+		{"too wide", "abc", 2, AlignCenter, "abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Align(tt.s, tt.width, tt.alignment); got != tt.want {
+				t.Errorf("Align() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package tablewriter
+
+import (
+	"fmt"
+	"hash/crc32"
+)
+
+// EnableTrailer writes a trailer line directly below the table with the number of non-header rows (default: no trailer).
+// If `checksum` is true, the trailer also includes a CRC-32 (IEEE) checksum of the non-header cells,
+// so that tables transferred between systems as text can be verified.
+func (tbl *Table) EnableTrailer(checksum bool) {
+	tbl.trailer = true
+	tbl.trailerChecksum = checksum
+}
+
+// stringifyTrailer returns the trailer line, e.g., "rows: 2, crc32: 1a2b3c4d".
+func (tbl *Table) stringifyTrailer() string {
+	numRows := tbl.rows.len() - tbl.numHeaderRows
+	if !tbl.trailerChecksum {
+		return fmt.Sprintf("rows: %d\n", numRows)
+	}
+	return fmt.Sprintf("rows: %d, crc32: %08x\n", numRows, tbl.checksum())
+}
+
+// checksum returns the CRC-32 (IEEE) checksum of the non-header cells.
+// Cells are separated by the ASCII unit separator and rows are terminated by the ASCII record separator,
+// so that moving text between cells changes the checksum.
+func (tbl *Table) checksum() uint32 {
+	h := crc32.NewIEEE()
+	for i := tbl.numHeaderRows; i < tbl.rows.len(); i++ {
+		cells := tbl.rows.at(i).cells
+		for k := range cells {
+			if k > 0 {
+				h.Write([]byte{0x1f})
+			}
+			h.Write([]byte(cells[k]))
+		}
+		h.Write([]byte{0x1e})
+	}
+	return h.Sum32()
+}
@@ -0,0 +1,62 @@
+package tablewriter
+
+import "testing"
+
+func TestTable_render_trailer(t *testing.T) {
+	tests := []struct {
+		name     string
+		checksum bool
+		want     string
+	}{
+		{"row count", false, "" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"|-----|\n" +
+			"| bar |\n" +
+			"| baz |\n" +
+			"+-----+\n" +
+			"rows: 2\n"},
+		{"checksum", true, "" +
+			"+-----+\n" +
+			"| foo |\n" +
+			"|-----|\n" +
+			"| bar |\n" +
+			"| baz |\n" +
+			"+-----+\n" +
+			"rows: 2, crc32: b4f28857\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo"}, [][]string{{"bar"}, {"baz"}})
+			tbl.EnableTrailer(tt.checksum)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_checksum(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b [][]string
+		same bool
+	}{
+		{"identical", [][]string{{"foo", "bar"}}, [][]string{{"foo", "bar"}}, true},
+		{"text moved between cells", [][]string{{"foo", "bar"}}, [][]string{{"foob", "ar"}}, false},
+		{"text moved between rows", [][]string{{"foo"}, {"bar"}}, [][]string{{"foob"}, {"ar"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestTable(nil, tt.a).checksum()
+			b := newTestTable(nil, tt.b).checksum()
+			if (a == b) != tt.same {
+				t.Errorf("Table.checksum() = %08x and %08x, want same = %v", a, b, tt.same)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@
 // label levels,
 // cell alignment,
 // handling overly-wide cells (truncate vs wrap),
+// ANSI cell styling,
+// AsciiDoc, reStructuredText, and Org-mode output,
 // and auto-merging repeat values in the same column.
 package tablewriter
 
@@ -33,8 +35,23 @@ var (
 	contentLabelEdge string
 )
 
+// A dividingRow identifies the position of a dividing row in a table, which determines its junction symbols.
+type dividingRow int
+
+const (
+	dividingTop dividingRow = iota
+	dividingHeader
+	dividingMiddle
+	dividingBottom
+)
+
+// junctions holds the left, inner, and right junction symbols of each type of dividing row.
+// An empty symbol falls back to the edge symbol of the row (BorderEdge, or HeaderEdge for the header border).
+var junctions [4][3]string
+
 // set default values
 func resetDefaults() {
+	junctions = [4][3]string{}
 	ChangeDefaults(Defaults{
 		BorderEdge:       "+",
 		BorderLabelEdge:  "++",
@@ -56,11 +73,22 @@ func init() {
 // All edge and filler symbols must be 1-rune wide, except for label edges which must be 2-runes wide.
 // MaxColWidth must be > 0.
 // Unsupported field values are ignored.
+//
+// The junction symbols are the 1-rune-wide symbols where dividing rows meet the vertical edges, e.g.,
+// TopLeft "┌", TopJunction "┬", and TopRight "┐" for the top border; HeaderLeft "├", HeaderCross "┼", and HeaderRight "┤"
+// for the border below the header rows; MiddleLeft, MiddleCross, and MiddleRight for the dividers between non-header rows;
+// and BottomLeft "└", BottomJunction "┴", and BottomRight "┘" for the bottom border.
+// Until a junction symbol is set, it is the same as the edge symbol of its row (BorderEdge, or HeaderEdge for the header border).
+// Label edges are drawn with the label edge symbols at every position.
 type Defaults struct {
 	BorderEdge, BorderLabelEdge, BorderFiller string
 	HeaderEdge, HeaderLabelEdge, HeaderFiller string
 	ContentEdge, ContentLabelEdge             string
 	MaxColWidth                               int
+	TopLeft, TopJunction, TopRight            string
+	HeaderLeft, HeaderCross, HeaderRight      string
+	MiddleLeft, MiddleCross, MiddleRight      string
+	BottomLeft, BottomJunction, BottomRight   string
 }
 
 // An Alignment configures how text is aligned in a cell.
@@ -73,19 +101,119 @@ const (
 	AlignRight
 	// AlignLeft left-justifies the cell
 	AlignLeft
+	// AlignDecimal lines up the numbers in a column on their decimal points, padding shorter fractional parts with spaces,
+	// and right-justifies the result. Cells that are not numbers are right-justified, and header cells are aligned as usual.
+	AlignDecimal
+)
+
+// A Side identifies the side of the table on which the label levels appear.
+type Side int
+
+const (
+	// SideLeft places the label levels in the leftmost columns.
+	SideLeft Side = iota
+	// SideRight places the label levels in the rightmost columns.
+	SideRight
+)
+
+// A Suppression identifies one or more parts of a table to omit when rendering.
+type Suppression int
+
+const (
+	// SuppressHeaders omits the header rows and the dividing row below them.
+	SuppressHeaders Suppression = 1 << iota
+	// SuppressTopBorder omits the border at the top of the table.
+	SuppressTopBorder
+	// SuppressBottomBorder omits the border at the bottom of the table.
+	SuppressBottomBorder
+	// SuppressLeftBorder omits the leftmost edge of every row.
+	SuppressLeftBorder
+	// SuppressRightBorder omits the rightmost edge of every row.
+	SuppressRightBorder
+	// SuppressAllButBody omits everything except the non-header rows.
+	SuppressAllButBody = SuppressHeaders | SuppressTopBorder | SuppressBottomBorder
+)
+
+// Line endings for SetLineEnding.
+const (
+	// LineEndingLF ends every rendered line with "\n".
+	LineEndingLF = "\n"
+	// LineEndingCRLF ends every rendered line with "\r\n", e.g., for tables written into files with Windows line endings.
+	LineEndingCRLF = "\r\n"
+	// LineEndingAuto ends every rendered line with "\r\n" if the program is running on Windows, and "\n" otherwise.
+	LineEndingAuto = "auto"
 )
 
 // A Table can be rendered into a stringified representation of content rows and dividing rows
 // with the results written into an io.Writer.
+// A Table reuses its render buffers across renders, and must not be rendered concurrently.
 type Table struct {
-	w                 io.Writer
-	rows              [][]string
-	alignment         Alignment
-	numHeaderRows     int
-	numLabelLevels    int
-	autoMerge         bool
-	truncateCells     bool
-	autoCenterHeaders bool
+	w                        io.Writer
+	rows                     rowStore
+	dividers                 []int
+	maxRows                  int
+	alignment                Alignment
+	smartAlignment           bool
+	padding                  *cellPadding
+	numHeaderRows            int
+	numLabelLevels           int
+	labelSide                Side
+	autoMerge                bool
+	mergePosition            MergePosition
+	mergeCounts              bool
+	mergeScope               MergeScope
+	placeholder              string
+	placeholderMerged        bool
+	truncateCells            bool
+	maxLinesPerCell          int
+	continuationMarker       string
+	continuationAlignment    Alignment
+	hasContinuationAlignment bool
+	strictWidths             bool
+	totalWidth               int
+	fixedWidths              []int
+	maxWidth                 int
+	minWidth                 int
+	widthObserver            func(colWidths []int) []int
+	autoCenterHeaders        bool
+	headerOverflow           Overflow
+	trimTrailingSpace        bool
+	crlf                     bool
+	tabWidth                 int
+	stripControls            bool
+	escapeControls           bool
+	escapeSeparators         bool
+	separatorReplacement     string
+	autoIndex                bool
+	indexOffset              int
+	hasStyles                bool
+	plainLinks               bool
+	locale                   *Locale
+	hasPins                  bool
+	hasValues                bool
+	headerStyle              Style
+	headerSubtext            []string
+	headerDivider            *headerDivider
+	zebraStripes             *[2]Style
+	rowStyler                func(row []string, tags map[string]string) Style
+	highlight                *highlight
+	exactColumnNames         bool
+	ignoreUnknownKeys        bool
+	metadata                 []metadataEntry
+	metadataPosition         MetadataPosition
+	trailer                  bool
+	trailerChecksum          bool
+	columns                  map[int]columnSettings
+	computed                 []computedColumn
+	groupBy                  *groupSettings
+	footer                   map[int]Aggregator
+	suppress                 Suppression
+	spacing                  int
+	clipIndicator            ClipIndicator
+	shapePolicy              ShapePolicy
+	shapeObserver            func(row, numCells, numCols int)
+	configErr                error
+	scratch                  *renderer
 }
 
 func singleWidthString(s string) bool {
@@ -126,4 +254,17 @@ func ChangeDefaults(defaults Defaults) {
 	if defaults.MaxColWidth > 0 {
 		maxColWidth = defaults.MaxColWidth
 	}
+	symbols := [4][3]string{
+		dividingTop:    {defaults.TopLeft, defaults.TopJunction, defaults.TopRight},
+		dividingHeader: {defaults.HeaderLeft, defaults.HeaderCross, defaults.HeaderRight},
+		dividingMiddle: {defaults.MiddleLeft, defaults.MiddleCross, defaults.MiddleRight},
+		dividingBottom: {defaults.BottomLeft, defaults.BottomJunction, defaults.BottomRight},
+	}
+	for row := range symbols {
+		for k, symbol := range symbols[row] {
+			if singleWidthString(symbol) {
+				junctions[row][k] = symbol
+			}
+		}
+	}
 }
@@ -0,0 +1,276 @@
+package tablewriter
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ptiger10/tablewriter/layout"
+)
+
+// view returns a table with all render-time transformations applied to its rows.
+// If no transformations apply, the table itself is returned.
+// Otherwise the returned table is a shallow copy with new rows, and the original table is unchanged.
+func (tbl *Table) view() *Table {
+	if !tbl.needsView() {
+		return tbl
+	}
+	rows := tbl.rows
+	if tbl.hasValues {
+		rows = withValues(rows)
+	}
+	if tbl.hasPins {
+		rows = withPinnedRows(rows, tbl.numHeaderRows)
+	}
+	if len(tbl.computed) > 0 {
+		rows = tbl.withComputedColumns(rows)
+	}
+	formatters := tbl.hasFormatters()
+	totals := tbl.percentTotals(rows)
+	v := *tbl
+	var footer record
+	if len(tbl.footer) > 0 {
+		footer = tbl.footerRow(rows)
+	}
+	// copied is true once rows and v.dividers no longer share storage with the table
+	copied := false
+	if tbl.groupBy != nil {
+		rows, v.dividers = tbl.groupRows(rows)
+		copied = true
+	}
+	elision, elided := -1, 0
+	if tbl.maxRows > 0 && rows.len()-tbl.numHeaderRows > tbl.maxRows {
+		elided = rows.len() - tbl.numHeaderRows - tbl.maxRows
+		rows, v.dividers, elision = elideRows(rows, v.dividers, tbl.numHeaderRows, tbl.maxRows)
+		copied = true
+	}
+	if footer.cells != nil {
+		if !copied {
+			rows = copyRows(rows)
+			v.dividers = append([]int(nil), tbl.dividers...)
+			copied = true
+		}
+		v.dividers = append(v.dividers, rows.len()-tbl.numHeaderRows)
+		rows.push(footer)
+	}
+	if tbl.headerSubtext != nil {
+		if !copied {
+			rows = copyRows(rows)
+		}
+		// computed columns have no subtext
+		subtext := make([]string, len(tbl.headerSubtext)+len(tbl.computed))
+		copy(subtext, tbl.headerSubtext)
+		rows.insert(tbl.numHeaderRows, record{cells: subtext, styles: subtextStyles(len(subtext))})
+		v.numHeaderRows++
+		if elision != -1 {
+			elision++
+		}
+	}
+	numHeaderRows := v.numHeaderRows
+	v.rows = rowStore{}
+	v.autoIndex = false
+	indexRight := tbl.labelSide == SideRight
+	if tbl.autoIndex {
+		v.numLabelLevels++
+		if !indexRight {
+			v.columns = tbl.shiftColumns(1)
+		}
+	}
+	var escapeSeparator func(cell string) string
+	if tbl.escapeSeparators {
+		escapeSeparator = separatorReplacer(tbl.separatorReplacement)
+	}
+	for i := 0; i < rows.len(); i++ {
+		row := rows.at(i)
+		// the row styler sees the stored cells, before any formatting
+		var rowStyle Style
+		if i >= numHeaderRows && tbl.rowStyler != nil {
+			rowStyle = tbl.rowStyler(row.cells, row.tags)
+		}
+		if tbl.tabWidth > 0 {
+			row = withCells(row, func(cell string) string { return layout.ExpandTabs(cell, tbl.tabWidth) })
+		}
+		if tbl.stripControls {
+			row = withCells(row, func(cell string) string { return layout.StripControls(cell, tbl.escapeControls) })
+		}
+		if escapeSeparator != nil {
+			row = withCells(row, escapeSeparator)
+		}
+		if i >= numHeaderRows && formatters && i != elision {
+			row = tbl.formatRow(row, totals)
+		}
+		if i >= numHeaderRows && tbl.placeholder != "" {
+			row = withPlaceholder(row, tbl.placeholder)
+		}
+		if tbl.autoIndex {
+			// header rows have a blank index, and rows after the elision row keep their positions in the full table
+			var index string
+			switch {
+			case i < numHeaderRows:
+			case i == elision:
+				index = "…"
+			case elision != -1 && i > elision:
+				index = strconv.Itoa(tbl.indexOffset + i - numHeaderRows + elided)
+			default:
+				index = strconv.Itoa(tbl.indexOffset + i - numHeaderRows + 1)
+			}
+			row = withIndex(row, index, indexRight)
+		}
+		if i < numHeaderRows && tbl.headerStyle != (Style{}) {
+			row = withStyle(row, tbl.headerStyle)
+		}
+		if rowStyle != (Style{}) {
+			row = withStyle(row, rowStyle)
+		}
+		if i >= numHeaderRows && tbl.zebraStripes != nil {
+			row = withStyle(row, tbl.zebraStripes[(i-numHeaderRows)%2])
+		}
+		row = transformText(row)
+		if i >= numHeaderRows && i != elision && tbl.highlight != nil {
+			// the index cell is never highlighted
+			skip := -1
+			if tbl.autoIndex {
+				skip = 0
+				if indexRight {
+					skip = len(row.cells) - 1
+				}
+			}
+			row = withHighlight(row, tbl.highlight, skip)
+		}
+		v.rows.push(row)
+	}
+	if tbl.autoMerge && tbl.mergeCounts {
+		v.annotateMergeCounts(elision)
+	}
+	if tbl.smartAlignment {
+		v.inferAlignments()
+	}
+	if tbl.hasDecimalAlignment() {
+		v.alignDecimals()
+	}
+	return &v
+}
+
+// plainView is like view, but without highlights, which are written into the cells as ANSI styles or markers.
+// Highlights apply only to Render, so that output without ANSI styles (e.g., RenderOrg and Snapshot) holds the plain text of each cell.
+func (tbl *Table) plainView() *Table {
+	if tbl.highlight == nil {
+		return tbl.view()
+	}
+	plain := *tbl
+	plain.highlight = nil
+	return plain.view()
+}
+
+// needsView returns true if any render-time transformations apply to the table.
+func (tbl *Table) needsView() bool {
+	return tbl.autoIndex || tbl.maxRows > 0 || (tbl.autoMerge && tbl.mergeCounts) || tbl.tabWidth > 0 || tbl.stripControls || tbl.escapeSeparators || tbl.hasPins || tbl.hasValues || tbl.headerSubtext != nil || tbl.groupBy != nil || len(tbl.footer) > 0 || tbl.placeholder != "" || tbl.smartAlignment || len(tbl.computed) > 0 || tbl.hasStyles || tbl.headerStyle != (Style{}) || tbl.zebraStripes != nil || tbl.rowStyler != nil || tbl.highlight != nil || tbl.clipIndicator != ClipNone || tbl.hasDecimalAlignment() || tbl.hasFormatters()
+}
+
+// withStyle returns a copy of `row` with every cell styled with `style`.
+// Any styles already set on individual cells take precedence.
+func withStyle(row record, style Style) record {
+	styles := make([]Style, len(row.cells))
+	for k := range styles {
+		styles[k] = style
+		if row.styles != nil {
+			styles[k] = row.styles[k].over(style)
+		}
+	}
+	return record{cells: row.cells, styles: styles}
+}
+
+// subtextStyles returns the styles of a header subtext row with `n` cells.
+func subtextStyles(n int) []Style {
+	styles := make([]Style, n)
+	for k := range styles {
+		styles[k] = Style{Dim: true}
+	}
+	return styles
+}
+
+// withPlaceholder returns `row`, or a copy of `row` with every empty cell replaced by `placeholder`.
+func withPlaceholder(row record, placeholder string) record {
+	var cells []string
+	for k := range row.cells {
+		if row.cells[k] != "" {
+			continue
+		}
+		if cells == nil {
+			cells = make([]string, len(row.cells))
+			copy(cells, row.cells)
+		}
+		cells[k] = placeholder
+	}
+	if cells == nil {
+		return row
+	}
+	return record{cells: cells, styles: row.styles}
+}
+
+// withCells returns `row`, or a copy of `row` if `transform` changes any of its cells.
+func withCells(row record, transform func(cell string) string) record {
+	var cells []string
+	for k := range row.cells {
+		cell := transform(row.cells[k])
+		if cell == row.cells[k] {
+			continue
+		}
+		if cells == nil {
+			cells = make([]string, len(row.cells))
+			copy(cells, row.cells)
+		}
+		cells[k] = cell
+	}
+	if cells == nil {
+		return row
+	}
+	return record{cells: cells, styles: row.styles}
+}
+
+// separatorReplacer returns a function that replaces the content edge symbol in a cell with `replacement`,
+// or escapes it with a backslash if `replacement` is empty.
+func separatorReplacer(replacement string) func(cell string) string {
+	if replacement == "" {
+		replacement = `\` + contentEdge
+	}
+	return func(cell string) string {
+		return strings.Replace(cell, contentEdge, replacement, -1)
+	}
+}
+
+// transformText returns a copy of `row` with any text transformations in its styles applied to its cells,
+// so that column widths can be computed from the transformed text.
+func transformText(row record) record {
+	var cells []string
+	for k := range row.styles {
+		if !row.styles[k].Uppercase {
+			continue
+		}
+		if cells == nil {
+			cells = make([]string, len(row.cells))
+			copy(cells, row.cells)
+		}
+		cells[k] = strings.ToUpper(cells[k])
+	}
+	if cells == nil {
+		return row
+	}
+	return record{cells: cells, styles: row.styles}
+}
+
+// withIndex returns a copy of `row` with an `index` cell prepended (or appended, if `right`).
+func withIndex(row record, index string, right bool) record {
+	indexed := record{cells: make([]string, 0, len(row.cells)+1)}
+	if right {
+		indexed.cells = append(append(indexed.cells, row.cells...), index)
+		if row.styles != nil {
+			indexed.styles = append(append([]Style(nil), row.styles...), Style{})
+		}
+		return indexed
+	}
+	indexed.cells = append(append(indexed.cells, index), row.cells...)
+	if row.styles != nil {
+		indexed.styles = append([]Style{{}}, row.styles...)
+	}
+	return indexed
+}
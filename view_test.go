@@ -0,0 +1,272 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTable_view(t *testing.T) {
+	type fields struct {
+		rows           [][]string
+		numHeaderRows  int
+		numLabelLevels int
+		autoIndex      bool
+		headerStyle    Style
+		zebraStripes   *[2]Style
+	}
+	tests := []struct {
+		name               string
+		fields             fields
+		wantRows           [][]string
+		wantNumLabelLevels int
+		wantFirstStyles    []Style
+	}{
+		{"no transformations",
+			fields{rows: [][]string{{"foo", "bar"}}, numHeaderRows: 0},
+			[][]string{{"foo", "bar"}}, 0, nil},
+		{"auto index - no header",
+			fields{rows: [][]string{{"foo"}, {"bar"}}, autoIndex: true},
+			[][]string{{"1", "foo"}, {"2", "bar"}}, 1, nil},
+		{"auto index - header and label levels",
+			fields{rows: [][]string{{"foo"}, {"bar"}, {"baz"}}, numHeaderRows: 1, numLabelLevels: 1, autoIndex: true},
+			[][]string{{"", "foo"}, {"1", "bar"}, {"2", "baz"}}, 2, nil},
+		{"header style - uppercase",
+			fields{rows: [][]string{{"foo", "bar"}, {"baz", "qux"}}, numHeaderRows: 1, headerStyle: Style{Bold: true, Uppercase: true}},
+			[][]string{{"FOO", "BAR"}, {"baz", "qux"}}, 0,
+			[]Style{{Bold: true, Uppercase: true}, {Bold: true, Uppercase: true}}},
+		{"header style - auto index",
+			fields{rows: [][]string{{"foo"}, {"baz"}}, numHeaderRows: 1, headerStyle: Style{Bold: true}, autoIndex: true},
+			[][]string{{"", "foo"}, {"1", "baz"}}, 1,
+			[]Style{{Bold: true}, {Bold: true}}},
+		{"zebra stripes - cell styles take precedence",
+			fields{rows: [][]string{{"foo"}, {"bar"}, {"baz"}}, numHeaderRows: 1,
+				zebraStripes: &[2]Style{{Background: ColorBlue}, {Background: ColorCyan}}},
+			[][]string{{"foo"}, {"bar"}, {"baz"}}, 0,
+			nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := &Table{
+				rows:           newRowStore(tt.fields.rows),
+				numHeaderRows:  tt.fields.numHeaderRows,
+				numLabelLevels: tt.fields.numLabelLevels,
+				autoIndex:      tt.fields.autoIndex,
+				headerStyle:    tt.fields.headerStyle,
+				zebraStripes:   tt.fields.zebraStripes,
+			}
+			got := tbl.view()
+			if !reflect.DeepEqual(got.rows.all(), tt.wantRows) {
+				t.Errorf("Table.view().rows -> %v, want %v", got.rows.all(), tt.wantRows)
+			}
+			if gotStyles := got.rows.at(0).styles; !reflect.DeepEqual(gotStyles, tt.wantFirstStyles) {
+				t.Errorf("Table.view().rows.at(0).styles -> %v, want %v", gotStyles, tt.wantFirstStyles)
+			}
+			if got.numLabelLevels != tt.wantNumLabelLevels {
+				t.Errorf("Table.view().numLabelLevels -> %v, want %v", got.numLabelLevels, tt.wantNumLabelLevels)
+			}
+			if !reflect.DeepEqual(tbl.rows.all(), tt.fields.rows) {
+				t.Errorf("Table.view() changed original rows -> %v, want %v", tbl.rows.all(), tt.fields.rows)
+			}
+		})
+	}
+}
+
+func Test_transformText(t *testing.T) {
+	tests := []struct {
+		name string
+		row  record
+		want record
+	}{
+		{"no styles", record{cells: []string{"foo"}}, record{cells: []string{"foo"}}},
+		{"no transformations", record{cells: []string{"foo"}, styles: []Style{{Bold: true}}},
+			record{cells: []string{"foo"}, styles: []Style{{Bold: true}}}},
+		{"uppercase", record{cells: []string{"foo", "bar"}, styles: []Style{{}, {Uppercase: true}}},
+			record{cells: []string{"foo", "BAR"}, styles: []Style{{}, {Uppercase: true}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]string{}, tt.row.cells...)
+			if got := transformText(tt.row); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("transformText() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.row.cells, original) {
+				t.Errorf("transformText() changed original cells -> %v, want %v", tt.row.cells, original)
+			}
+		})
+	}
+}
+
+func TestTable_view_zebraStripes(t *testing.T) {
+	tbl := NewTable(nil)
+	tbl.AppendHeaderRow([]string{"foo"})
+	tbl.AppendRows([][]string{{"bar"}, {"baz"}})
+	tbl.AppendStyledRow([]Cell{{Text: "qux", Style: Style{Background: ColorRed}}})
+	tbl.EnableZebraStripes(Style{Background: ColorBlue}, Style{Background: ColorCyan, Bold: true})
+	v := tbl.view()
+	want := [][]Style{
+		nil,
+		{{Background: ColorBlue}},
+		{{Background: ColorCyan, Bold: true}},
+		{{Background: ColorRed}},
+	}
+	for i := range want {
+		if got := v.rows.at(i).styles; !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("Table.view().rows.at(%d).styles -> %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestTable_SetEmptyCellPlaceholder(t *testing.T) {
+	tests := []struct {
+		name          string
+		includeMerged bool
+		want          string
+	}{
+		{"merged cells stay blank", false, "" +
+			"+-----+-----+\n" +
+			"| foo | bar |\n" +
+			"|-----|-----|\n" +
+			"|  a  |  -  |\n" +
+			"|     |  b  |\n" +
+			"|  -  |  c  |\n" +
+			"+-----+-----+\n"},
+		{"merged cells show placeholder", true, "" +
+			"+-----+-----+\n" +
+			"| foo | bar |\n" +
+			"|-----|-----|\n" +
+			"|  a  |  -  |\n" +
+			"|  -  |  b  |\n" +
+			"|  -  |  c  |\n" +
+			"+-----+-----+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"a", ""}, {"a", "b"}, {"", "c"}})
+			tbl.MergeRepeats()
+			tbl.SetEmptyCellPlaceholder("-", tt.includeMerged)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetHeaderSubtext(t *testing.T) {
+	tbl := newTestTable([]string{"latency", "requests"}, [][]string{{"12", "3"}})
+	if err := tbl.SetHeaderSubtext([]string{"(ms)"}); err == nil {
+		t.Errorf("Table.SetHeaderSubtext() with wrong shape error = nil, want error")
+	}
+	if err := tbl.SetHeaderSubtext([]string{"(ms)", "(count)"}); err != nil {
+		t.Fatalf("Table.SetHeaderSubtext() error = %v", err)
+	}
+	tbl.EnableAutoIndex()
+	want := "" +
+		"+---++---------+----------+\n" +
+		"|   || latency | requests |\n" +
+		"|   ||  \x1b[2m(ms)\x1b[0m   | \x1b[2m(count)\x1b[0m  |\n" +
+		"|---||---------|----------|\n" +
+		"| 1 ||   12    |    3     |\n" +
+		"+---++---------+----------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	if tbl.numHeaderRows != 1 || tbl.rows.len() != 2 {
+		t.Errorf("Table.SetHeaderSubtext() changed stored rows")
+	}
+}
+
+func TestTable_StripControlCharacters(t *testing.T) {
+	tests := []struct {
+		name   string
+		escape bool
+		want   string
+	}{
+		{"strip", false, "" +
+			"+---------+\n" +
+			"| message |\n" +
+			"|---------|\n" +
+			"| foo bar |\n" +
+			"+---------+\n"},
+		{"escape", true, "" +
+			"+-------------+\n" +
+			"|   message   |\n" +
+			"|-------------|\n" +
+			"| foo bar\\x0d |\n" +
+			"+-------------+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"message"}, [][]string{{"foo\tbar\r"}})
+			tbl.StripControlCharacters(tt.escape)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetTabWidth(t *testing.T) {
+	tbl := newTestTable([]string{"config"}, [][]string{{"a\t1\nabc\t2"}})
+	tbl.SetTabWidth(4)
+	tbl.SetAlignment(AlignLeft)
+	want := "" +
+		"+--------+\n" +
+		"| config |\n" +
+		"|--------|\n" +
+		"| a   1  |\n" +
+		"| abc 2  |\n" +
+		"+--------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}
+
+func TestTable_EscapeSeparators(t *testing.T) {
+	tests := []struct {
+		name        string
+		replacement string
+		want        string
+	}{
+		{"escape", "", "" +
+			"+--------+\n" +
+			"|  pipe  |\n" +
+			"|--------|\n" +
+			"| a \\| b |\n" +
+			"+--------+\n"},
+		{"replace", "¦", "" +
+			"+-------+\n" +
+			"| pipe  |\n" +
+			"|-------|\n" +
+			"| a ¦ b |\n" +
+			"+-------+\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"pipe"}, [][]string{{"a | b"}})
+			tbl.EscapeSeparators(tt.replacement)
+			got, err := tbl.render()
+			if err != nil {
+				t.Fatalf("Table.render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Table.render() -> %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
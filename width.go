@@ -0,0 +1,192 @@
+package tablewriter
+
+import "fmt"
+
+// SetTotalWidth causes the table to be rendered exactly `n` columns wide, so that multiple stacked tables in one report line up.
+// A narrower table is widened by distributing the extra space across its columns, starting from the leftmost column.
+// A wider table is narrowed by shrinking its widest columns, whose cells then wrap or truncate, down to a width of 1.
+// Values less than 1 restore the natural width. (Default: natural width).
+func (tbl *Table) SetTotalWidth(n int) {
+	tbl.totalWidth = n
+}
+
+// A ShrinkPriority configures when a column is narrowed to fit a total width set with SetTotalWidth.
+type ShrinkPriority int
+
+const (
+	// ShrinkNormal narrows the column after every ShrinkFirst column has been narrowed as much as possible.
+	ShrinkNormal ShrinkPriority = iota
+	// ShrinkFirst narrows the column before any other column (e.g., a free-text column that can absorb the squeeze).
+	ShrinkFirst
+	// ShrinkLast narrows the column only after every other column has been narrowed as much as possible
+	// (e.g., a key identifier column that should stay intact).
+	ShrinkLast
+)
+
+// order returns the position of `p` in the order in which columns are narrowed.
+func (p ShrinkPriority) order() int {
+	switch p {
+	case ShrinkFirst:
+		return 0
+	case ShrinkLast:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// SetColumnPriority sets when column `col` (starting at 0) is narrowed to fit a total width, relative to the other columns.
+// Within the same priority, the widest column is narrowed first. (Default: ShrinkNormal).
+func (tbl *Table) SetColumnPriority(col int, priority ShrinkPriority) {
+	tbl.updateColumn(col, func(c *columnSettings) { c.priority = priority })
+}
+
+// SetWidthObserver registers a callback that receives the computed width of every column each time the table is rendered,
+// after any total width is applied and before any row is stringified (e.g., to log or verify a layout, or to apply custom fitting logic).
+// The callback receives a copy of the widths. If it returns a non-nil slice, those widths are used instead,
+// and must have one positive width per column. Cells wider than an adjusted column wrap or truncate as usual.
+// A nil observer removes any existing observer.
+func (tbl *Table) SetWidthObserver(observer func(colWidths []int) []int) {
+	tbl.widthObserver = observer
+}
+
+// SetColumnWidths renders every column with the corresponding width in `colWidths` (excluding padding), starting with the first column,
+// instead of its natural width, so that several tables printed in sequence share identical column boundaries (see ComputeColumnWidths).
+// Any total width is ignored. Cells wider than their column wrap or truncate as usual. A nil slice restores the natural widths.
+// Returns an error unless every width is positive and, if the table has rows, there is one width per rendered column
+// (including computed columns and any auto index). Rendering returns an error if the number of rendered columns has since changed.
+func (tbl *Table) SetColumnWidths(colWidths []int) error {
+	if colWidths == nil {
+		tbl.fixedWidths = nil
+		return nil
+	}
+	for k, w := range colWidths {
+		if w < 1 {
+			return fmt.Errorf("tbl.SetColumnWidths(): column %d: width must be positive (%d)", k, w)
+		}
+	}
+	if numCols := tbl.numRenderedColumns(); tbl.rows.len() > 0 && len(colWidths) != numCols {
+		return fmt.Errorf("tbl.SetColumnWidths(): must have one width per column (%d != %d)", len(colWidths), numCols)
+	}
+	tbl.fixedWidths = append([]int(nil), colWidths...)
+	return nil
+}
+
+// numRenderedColumns returns the number of columns in the rendered table, including columns added at render time.
+func (tbl *Table) numRenderedColumns() int {
+	numCols := tbl.NumColumns() + len(tbl.computed)
+	if tbl.autoIndex {
+		numCols++
+	}
+	return numCols
+}
+
+// WidthOptions configures how ComputeColumnWidths measures columns.
+type WidthOptions struct {
+	// NumHeaderRows is the number of header rows at the top of the rows, which are never narrowed by MaxColWidth.
+	NumHeaderRows int
+	// MaxColWidth is the maximum width of the non-header cells in every column (default: the maximum set with ChangeDefaults).
+	MaxColWidth int
+}
+
+// ComputeColumnWidths returns the width (excluding padding) of every column in `rows` as a table would render it,
+// so that callers can align several tables, or non-table output, with the same algorithm.
+// Widths are measured in terminal columns, excluding ANSI control sequences, and multi-line cells are as wide as their widest line.
+// Rows with fewer cells than the widest row are treated as if padded with empty cells.
+func ComputeColumnWidths(rows [][]string, opts WidthOptions) []int {
+	var numCols int
+	for _, row := range rows {
+		if len(row) > numCols {
+			numCols = len(row)
+		}
+	}
+	if numCols == 0 {
+		return nil
+	}
+	tbl := &Table{numHeaderRows: opts.NumHeaderRows, maxWidth: opts.MaxColWidth}
+	tbl.rows.grow(len(rows))
+	for _, row := range rows {
+		cells := make([]string, numCols)
+		copy(cells, row)
+		tbl.rows.push(record{cells: cells})
+	}
+	return tbl.resizeColWidths()
+}
+
+// checkObservedWidths returns an error unless `colWidths` has one positive width for each of `numCols` columns.
+func checkObservedWidths(colWidths []int, numCols int) error {
+	if len(colWidths) != numCols {
+		return fmt.Errorf("width observer: must return one width per column (%d != %d)", len(colWidths), numCols)
+	}
+	for k, w := range colWidths {
+		if w < 1 {
+			return fmt.Errorf("width observer: column %d: width must be positive (%d)", k, w)
+		}
+	}
+	return nil
+}
+
+// lineWidth returns the rendered width of a line with columns of `colWidths`, including padding and edges.
+func lineWidth(colWidths []int, spec rowLayout) int {
+	edge, labelEdge := runeWidth(contentEdge), runeWidth(contentLabelEdge)
+	if spec.spacing > 0 {
+		edge, labelEdge = spec.spacing, spec.spacing
+	}
+	var ret int
+	if !spec.noLeftEdge {
+		ret += edge
+	}
+	for k, width := range colWidths {
+		pad := defaultPadding
+		if spec.paddings != nil {
+			pad = spec.paddings[k]
+		}
+		ret += pad.left + width + pad.right
+		switch {
+		case k == len(colWidths)-1 && spec.noRightEdge:
+			// no rightmost edge
+		case k == spec.labelEdge:
+			ret += labelEdge
+		default:
+			ret += edge
+		}
+	}
+	return ret
+}
+
+// fitTotalWidth adjusts `colWidths` in place so that lines are `total` wide, if possible.
+// Columns are narrowed according to `priorities`, which has one priority per column,
+// and never below 1 or their width in `minWidths`.
+func fitTotalWidth(colWidths []int, spec rowLayout, total int, priorities []ShrinkPriority, minWidths []int) {
+	if len(colWidths) == 0 {
+		return
+	}
+	diff := total - lineWidth(colWidths, spec)
+	// widen every column evenly, with any remainder going to the leftmost columns
+	if diff > 0 {
+		for k := range colWidths {
+			colWidths[k] += diff / len(colWidths)
+			if k < diff%len(colWidths) {
+				colWidths[k]++
+			}
+		}
+		return
+	}
+	// narrow the widest column with the earliest priority one space at a time
+	for ; diff < 0; diff++ {
+		next := -1
+		for k := range colWidths {
+			if colWidths[k] <= 1 || colWidths[k] <= minWidths[k] {
+				continue
+			}
+			if next == -1 || priorities[k].order() < priorities[next].order() ||
+				priorities[k].order() == priorities[next].order() && colWidths[k] > colWidths[next] {
+				next = k
+			}
+		}
+		if next == -1 {
+			return
+		}
+		colWidths[next]--
+	}
+}
@@ -0,0 +1,238 @@
+package tablewriter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_fitTotalWidth(t *testing.T) {
+	tests := []struct {
+		name       string
+		colWidths  []int
+		spec       rowLayout
+		total      int
+		priorities []ShrinkPriority
+		minWidths  []int
+		want       []int
+	}{
+		{"unchanged", []int{3, 3}, rowLayout{labelEdge: -1}, 13, nil, nil, []int{3, 3}},
+		{"widen with remainder", []int{3, 3}, rowLayout{labelEdge: -1}, 16, nil, nil, []int{5, 4}},
+		{"widen with label edge", []int{3, 3}, rowLayout{labelEdge: 0}, 16, nil, nil, []int{4, 4}},
+		{"widen without outer edges", []int{3, 3}, rowLayout{labelEdge: -1, noLeftEdge: true, noRightEdge: true}, 14, nil, nil, []int{5, 4}},
+		{"narrow widest", []int{10, 3}, rowLayout{labelEdge: -1}, 15, nil, nil, []int{5, 3}},
+		{"narrow to minimum", []int{2, 2}, rowLayout{labelEdge: -1}, 1, nil, nil, []int{1, 1}},
+		{"shrink first", []int{10, 3}, rowLayout{labelEdge: -1}, 15, []ShrinkPriority{ShrinkNormal, ShrinkFirst}, nil, []int{7, 1}},
+		{"shrink last", []int{10, 3, 5}, rowLayout{labelEdge: -1}, 17, []ShrinkPriority{ShrinkLast, ShrinkNormal, ShrinkNormal}, nil, []int{5, 1, 1}},
+		{"minimum width", []int{10, 10}, rowLayout{labelEdge: -1}, 12, nil, []int{4, 4}, []int{4, 4}},
+		{"minimum width of one column", []int{10, 10}, rowLayout{labelEdge: -1}, 17, nil, []int{8, 0}, []int{8, 2}},
+		{"shrink last when necessary", []int{4, 4}, rowLayout{labelEdge: -1}, 1, []ShrinkPriority{ShrinkLast, ShrinkNormal}, nil, []int{1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priorities := tt.priorities
+			if priorities == nil {
+				priorities = make([]ShrinkPriority, len(tt.colWidths))
+			}
+			minWidths := tt.minWidths
+			if minWidths == nil {
+				minWidths = make([]int, len(tt.colWidths))
+			}
+			fitTotalWidth(tt.colWidths, tt.spec, tt.total, priorities, minWidths)
+			if !reflect.DeepEqual(tt.colWidths, tt.want) {
+				t.Errorf("fitTotalWidth() -> %v, want %v", tt.colWidths, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetTotalWidth(t *testing.T) {
+	tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"a", "b"}})
+	tbl.SetTotalWidth(20)
+	width, _, err := tbl.Dimensions()
+	if err != nil {
+		t.Fatalf("Table.Dimensions() error = %v", err)
+	}
+	if width != 20 {
+		t.Errorf("Table.SetTotalWidth() rendered width -> %v, want 20", width)
+	}
+}
+
+func TestTable_SetWidthObserver(t *testing.T) {
+	tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"abcdef", "b"}})
+	var observed []int
+	tbl.SetWidthObserver(func(colWidths []int) []int {
+		observed = append([]int(nil), colWidths...)
+		colWidths[0] = 3
+		return colWidths
+	})
+	want := "" +
+		"+-----+-----+\n" +
+		"| foo | bar |\n" +
+		"|-----|-----|\n" +
+		"| ab- |  b  |\n" +
+		"| cd- |     |\n" +
+		"| ef  |     |\n" +
+		"+-----+-----+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	if observed[0] != 6 || observed[1] != 3 {
+		t.Errorf("Table.SetWidthObserver() observed %v, want [6 3]", observed)
+	}
+
+	tests := []struct {
+		name   string
+		widths []int
+	}{
+		{"too few columns", []int{3}},
+		{"zero width", []int{3, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl.SetWidthObserver(func([]int) []int { return tt.widths })
+			if _, err := tbl.render(); err == nil {
+				t.Errorf("Table.render() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestComputeColumnWidths(t *testing.T) {
+	ChangeDefaults(Defaults{MaxColWidth: 5})
+	defer resetDefaults()
+	tests := []struct {
+		name string
+		rows [][]string
+		opts WidthOptions
+		want []int
+	}{
+		{"natural widths", [][]string{{"id", "name"}, {"1", "foo"}}, WidthOptions{}, []int{2, 4}},
+		{"default max width", [][]string{{"abcdefgh", "b"}}, WidthOptions{}, []int{5, 1}},
+		{"max width", [][]string{{"abcdefgh", "b"}}, WidthOptions{MaxColWidth: 3}, []int{3, 1}},
+		{"header rows are not narrowed", [][]string{{"abcdefgh"}, {"a"}}, WidthOptions{NumHeaderRows: 1, MaxColWidth: 3}, []int{8}},
+		{"multi-line and styled cells", [][]string{{"ab\nabc", "\x1b[1mab\x1b[0m"}}, WidthOptions{}, []int{3, 2}},
+		{"ragged rows", [][]string{{"a"}, {"a", "bcd"}}, WidthOptions{}, []int{1, 3}},
+		{"no rows", nil, WidthOptions{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ComputeColumnWidths(tt.rows, tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ComputeColumnWidths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_SetColumnWidths(t *testing.T) {
+	a := newTestTable([]string{"id", "name"}, [][]string{{"1", "foo"}})
+	b := newTestTable([]string{"id", "name"}, [][]string{{"12345", "a"}})
+	colWidths := ComputeColumnWidths(append(a.HeaderRows(), append(a.Rows(), b.Rows()...)...), WidthOptions{NumHeaderRows: 1})
+	a.SetColumnWidths(colWidths)
+	b.SetColumnWidths(colWidths)
+	want := "" +
+		"+-------+------+\n" +
+		"|  id   | name |\n" +
+		"|-------|------|\n" +
+		"|   1   | foo  |\n" +
+		"+-------+------+\n"
+	got, err := a.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+	aWidth, _, _ := a.Dimensions()
+	bWidth, _, _ := b.Dimensions()
+	if aWidth != bWidth {
+		t.Errorf("Table.SetColumnWidths() rendered widths %v and %v, want equal", aWidth, bWidth)
+	}
+
+	// narrower widths wrap cells
+	if err := a.SetColumnWidths([]int{1, 4}); err != nil {
+		t.Fatalf("Table.SetColumnWidths() error = %v", err)
+	}
+	want = "" +
+		"+---+------+\n" +
+		"| i | name |\n" +
+		"| d |      |\n" +
+		"|---|------|\n" +
+		"| 1 | foo  |\n" +
+		"+---+------+\n"
+	got, err = a.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+
+	// the number of rendered columns changed since the widths were set
+	a.EnableAutoIndex()
+	if _, err := a.render(); err == nil {
+		t.Errorf("Table.render() with stale column widths error = nil, want error")
+	}
+	if err := a.SetColumnWidths([]int{1, 1, 4}); err != nil {
+		t.Errorf("Table.SetColumnWidths() with auto index error = %v, want nil", err)
+	}
+}
+
+func TestTable_SetColumnWidths_invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		colWidths []int
+		wantErr   bool
+	}{
+		{"pass", []int{1, 2}, false},
+		{"restore natural widths", nil, false},
+		{"too few widths", []int{1}, true},
+		{"too many widths", []int{1, 2, 3}, true},
+		{"zero width", []int{1, 0}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := newTestTable([]string{"foo", "bar"}, [][]string{{"a", "b"}})
+			if err := tbl.SetColumnWidths(tt.colWidths); (err != nil) != tt.wantErr {
+				t.Errorf("Table.SetColumnWidths() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	// widths for a table without rows are validated when it is rendered
+	tbl := NewTable(nil)
+	if err := tbl.SetColumnWidths([]int{1}); err != nil {
+		t.Fatalf("Table.SetColumnWidths() on empty table error = %v", err)
+	}
+	tbl.AppendRow([]string{"a", "b"})
+	if _, err := tbl.render(); err == nil {
+		t.Errorf("Table.render() error = nil, want error")
+	}
+}
+
+func TestTable_SetColumnPriority(t *testing.T) {
+	tbl := newTestTable([]string{"id", "description"}, [][]string{{"abc-123", "a long description"}})
+	tbl.SetAlignment(AlignLeft)
+	tbl.SetColumnPriority(0, ShrinkLast)
+	tbl.SetTotalWidth(20)
+	// without a priority, the id column would be narrowed to 6
+	want := "" +
+		"+---------+--------+\n" +
+		"|   id    | descr- |\n" +
+		"|         | iption |\n" +
+		"|---------|--------|\n" +
+		"| abc-123 | a lon- |\n" +
+		"|         | g des- |\n" +
+		"|         | cript- |\n" +
+		"|         | ion    |\n" +
+		"+---------+--------+\n"
+	got, err := tbl.render()
+	if err != nil {
+		t.Fatalf("Table.render() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("Table.render() -> %v, want %v", got, want)
+	}
+}